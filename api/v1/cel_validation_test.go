@@ -0,0 +1,243 @@
+package v1_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+const crdDir = "../../config/crd/bases"
+
+// loadValidator compiles the CEL rules of the generated CRD for the given resource.
+func loadValidator(t *testing.T, resource string) (*cel.Validator, *structuralschema.Structural) {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(crdDir, "kubernetes.auth0.com_"+resource+".yaml"))
+	if err != nil {
+		t.Fatalf("failed to read CRD: %v", err)
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(data, crd); err != nil {
+		t.Fatalf("failed to decode CRD: %v", err)
+	}
+
+	internal := &apiextensions.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(crd.Spec.Versions[0].Schema.OpenAPIV3Schema, internal, nil); err != nil {
+		t.Fatalf("failed to convert schema: %v", err)
+	}
+
+	structural, err := structuralschema.NewStructural(internal)
+	if err != nil {
+		t.Fatalf("schema is not structural: %v", err)
+	}
+
+	validator := cel.NewValidator(structural, true, celconfig.PerCallLimit)
+	if validator == nil {
+		t.Fatalf("CRD %s has no CEL rules", resource)
+	}
+	return validator, structural
+}
+
+type celCase struct {
+	name    string
+	obj     runtime.Object
+	wantErr string
+}
+
+func runCelCases(t *testing.T, resource string, cases []celCase) {
+	t.Helper()
+	validator, structural := loadValidator(t, resource)
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.obj)
+			if err != nil {
+				t.Fatalf("failed to convert object: %v", err)
+			}
+
+			errs, _ := validator.Validate(context.Background(), field.NewPath(""), structural, obj, nil, celconfig.RuntimeCELCostBudget)
+			if tc.wantErr == "" {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if !strings.Contains(errs.ToAggregate().Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, errs)
+			}
+		})
+	}
+}
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func tenantRef() *v1.V1TenantReference {
+	return &v1.V1TenantReference{Name: "tenant"}
+}
+
+func TestA0ClientCelRules(t *testing.T) {
+	client := func(mutate func(spec *v1.A0ClientSpec)) *v1.A0Client {
+		c := &v1.A0Client{Spec: v1.A0ClientSpec{TenantRef: tenantRef(), Conf: &v1.ClientConf{}}}
+		mutate(&c.Spec)
+		return c
+	}
+
+	runCelCases(t, "a0clients", []celCase{
+		{
+			name: "valid",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Policy = []v1.V1EntityPolicyType{v1.PolicyTypeCreate, v1.PolicyTypeUpdate}
+				spec.Find = &v1.ClientFind{CallbackUrls: []string{"https://example.com/callback"}, CallbackUrlMatchMode: strPtr("loose")}
+				spec.Conf.EnabledConnections = []v1.V1ConnectionReference{{Name: strPtr("db")}, {Id: strPtr("con_123")}}
+			}),
+		},
+		{
+			name: "duplicate policy",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Policy = []v1.V1EntityPolicyType{v1.PolicyTypeCreate, v1.PolicyTypeCreate}
+			}),
+			wantErr: "policy entries must be unique",
+		},
+		{
+			name: "find with client_id and callback_urls",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Find = &v1.ClientFind{ClientId: strPtr("abc"), CallbackUrls: []string{"https://example.com/callback"}}
+			}),
+			wantErr: "client_id and callback_urls are mutually exclusive",
+		},
+		{
+			name: "find match mode without callback_urls",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Find = &v1.ClientFind{ClientId: strPtr("abc"), CallbackUrlMatchMode: strPtr("strict")}
+			}),
+			wantErr: "callback_url_match_mode requires callback_urls",
+		},
+		{
+			name: "connection reference with name and id",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Conf.EnabledConnections = []v1.V1ConnectionReference{{Name: strPtr("db"), Id: strPtr("con_123")}}
+			}),
+			wantErr: "at most one of name or id may be set",
+		},
+		{
+			name: "selected initiators with mode all",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Conf.OidcLogout = &v1.OidcLogoutConfig{BackchannelLogoutInitiators: &v1.BackchannelLogoutInitiators{
+					Mode:               strPtr("all"),
+					SelectedInitiators: []string{"rp-logout"},
+				}}
+			}),
+			wantErr: "selected_initiators may only be set when mode is custom",
+		},
+		{
+			name: "selected initiators with mode custom",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Conf.OidcLogout = &v1.OidcLogoutConfig{BackchannelLogoutInitiators: &v1.BackchannelLogoutInitiators{
+					Mode:               strPtr("custom"),
+					SelectedInitiators: []string{"rp-logout"},
+				}}
+			}),
+		},
+		{
+			name: "idle token lifetime exceeds token lifetime",
+			obj: client(func(spec *v1.A0ClientSpec) {
+				spec.Init = &v1.ClientConf{RefreshToken: &v1.RefreshToken{TokenLifetime: int32Ptr(3600), IdleTokenLifetime: int32Ptr(7200)}}
+			}),
+			wantErr: "idle_token_lifetime must not exceed token_lifetime",
+		},
+	})
+}
+
+func TestA0ClientGrantCelRules(t *testing.T) {
+	grant := func(ref *v1.V1ClientReference) *v1.A0ClientGrant {
+		return &v1.A0ClientGrant{Spec: v1.A0ClientGrantSpec{
+			TenantRef: tenantRef(),
+			Conf:      &v1.ClientGrantConf{ClientRef: ref, Audience: &v1.V1ResourceServerReference{Identifier: strPtr("https://api.example.com")}},
+		}}
+	}
+
+	runCelCases(t, "a0clientgrants", []celCase{
+		{name: "client reference by name", obj: grant(&v1.V1ClientReference{Name: strPtr("app")})},
+		{name: "client reference by id", obj: grant(&v1.V1ClientReference{Id: strPtr("abc")})},
+		{
+			name:    "client reference with name and id",
+			obj:     grant(&v1.V1ClientReference{Name: strPtr("app"), Id: strPtr("abc")}),
+			wantErr: "at most one of name or id may be set",
+		},
+		{
+			name: "audience with name and id",
+			obj: func() *v1.A0ClientGrant {
+				g := grant(&v1.V1ClientReference{Name: strPtr("app")})
+				g.Spec.Conf.Audience = &v1.V1ResourceServerReference{Name: strPtr("api"), Id: strPtr("abc")}
+				return g
+			}(),
+			wantErr: "at most one of name or id may be set",
+		},
+	})
+}
+
+func TestA0ResourceServerCelRules(t *testing.T) {
+	resourceServer := func(conf *v1.ResourceServerConf) *v1.A0ResourceServer {
+		return &v1.A0ResourceServer{Spec: v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: conf}}
+	}
+
+	runCelCases(t, "a0resourceservers", []celCase{
+		{
+			name: "valid",
+			obj:  resourceServer(&v1.ResourceServerConf{TokenLifetime: int32Ptr(86400), TokenLifetimeForWeb: int32Ptr(7200), SigningAlgorithm: strPtr("RS256")}),
+		},
+		{
+			name:    "web token lifetime exceeds token lifetime",
+			obj:     resourceServer(&v1.ResourceServerConf{TokenLifetime: int32Ptr(7200), TokenLifetimeForWeb: int32Ptr(86400)}),
+			wantErr: "token_lifetime_for_web must not exceed token_lifetime",
+		},
+		{
+			// Auth0 generates the signing secret when it is not set
+			name: "HS256 without signing secret",
+			obj:  resourceServer(&v1.ResourceServerConf{SigningAlgorithm: strPtr("HS256")}),
+		},
+	})
+}
+
+func TestA0ConnectionCelRules(t *testing.T) {
+	runCelCases(t, "a0connections", []celCase{
+		{
+			name: "duplicate policy",
+			obj: &v1.A0Connection{Spec: v1.A0ConnectionSpec{
+				TenantRef: tenantRef(),
+				Policy:    []v1.V1EntityPolicyType{v1.PolicyTypeDelete, v1.PolicyTypeDelete},
+				Conf:      &v1.ConnectionConf{},
+			}},
+			wantErr: "policy entries must be unique",
+		},
+	})
+}
+
+func TestA0TenantCelRules(t *testing.T) {
+	tenant := func(conf *v1.TenantConf) *v1.A0Tenant {
+		return &v1.A0Tenant{Spec: v1.A0TenantSpec{Name: "tenant", Conf: conf}}
+	}
+
+	runCelCases(t, "a0tenants", []celCase{
+		{name: "valid", obj: tenant(&v1.TenantConf{SessionLifetime: int32Ptr(168), IdleSessionLifetime: int32Ptr(72)})},
+		{
+			name:    "idle session lifetime exceeds session lifetime",
+			obj:     tenant(&v1.TenantConf{SessionLifetime: int32Ptr(24), IdleSessionLifetime: int32Ptr(72)}),
+			wantErr: "idle_session_lifetime must not exceed session_lifetime",
+		},
+	})
+}
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The add-on types keep the keys they do not model in their Unknown field, so that add-ons and settings added to
+// Auth0 after these types were written survive decoding and encoding a client, like the IDictionary add-ons of the
+// C# operator.
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *ClientAddons) UnmarshalJSON(data []byte) error {
+	type plain ClientAddons
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in ClientAddons) MarshalJSON() ([]byte, error) {
+	type plain ClientAddons
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonEnabled) UnmarshalJSON(data []byte) error {
+	type plain AddonEnabled
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonEnabled) MarshalJSON() ([]byte, error) {
+	type plain AddonEnabled
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonAws) UnmarshalJSON(data []byte) error {
+	type plain AddonAws
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonAws) MarshalJSON() ([]byte, error) {
+	type plain AddonAws
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonAzureMobileServices) UnmarshalJSON(data []byte) error {
+	type plain AddonAzureMobileServices
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonAzureMobileServices) MarshalJSON() ([]byte, error) {
+	type plain AddonAzureMobileServices
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonAzureServiceBus) UnmarshalJSON(data []byte) error {
+	type plain AddonAzureServiceBus
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonAzureServiceBus) MarshalJSON() ([]byte, error) {
+	type plain AddonAzureServiceBus
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonEchoSign) UnmarshalJSON(data []byte) error {
+	type plain AddonEchoSign
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonEchoSign) MarshalJSON() ([]byte, error) {
+	type plain AddonEchoSign
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonEgnyte) UnmarshalJSON(data []byte) error {
+	type plain AddonEgnyte
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonEgnyte) MarshalJSON() ([]byte, error) {
+	type plain AddonEgnyte
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonFirebase) UnmarshalJSON(data []byte) error {
+	type plain AddonFirebase
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonFirebase) MarshalJSON() ([]byte, error) {
+	type plain AddonFirebase
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonNewRelic) UnmarshalJSON(data []byte) error {
+	type plain AddonNewRelic
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonNewRelic) MarshalJSON() ([]byte, error) {
+	type plain AddonNewRelic
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonOffice365) UnmarshalJSON(data []byte) error {
+	type plain AddonOffice365
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonOffice365) MarshalJSON() ([]byte, error) {
+	type plain AddonOffice365
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSalesForce) UnmarshalJSON(data []byte) error {
+	type plain AddonSalesForce
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSalesForce) MarshalJSON() ([]byte, error) {
+	type plain AddonSalesForce
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSalesForceApi) UnmarshalJSON(data []byte) error {
+	type plain AddonSalesForceApi
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSalesForceApi) MarshalJSON() ([]byte, error) {
+	type plain AddonSalesForceApi
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSamlp) UnmarshalJSON(data []byte) error {
+	type plain AddonSamlp
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSamlp) MarshalJSON() ([]byte, error) {
+	type plain AddonSamlp
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSamlpLogout) UnmarshalJSON(data []byte) error {
+	type plain AddonSamlpLogout
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSamlpLogout) MarshalJSON() ([]byte, error) {
+	type plain AddonSamlpLogout
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSapApi) UnmarshalJSON(data []byte) error {
+	type plain AddonSapApi
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSapApi) MarshalJSON() ([]byte, error) {
+	type plain AddonSapApi
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSharePoint) UnmarshalJSON(data []byte) error {
+	type plain AddonSharePoint
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSharePoint) MarshalJSON() ([]byte, error) {
+	type plain AddonSharePoint
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonSpringCM) UnmarshalJSON(data []byte) error {
+	type plain AddonSpringCM
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonSpringCM) MarshalJSON() ([]byte, error) {
+	type plain AddonSpringCM
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonZendesk) UnmarshalJSON(data []byte) error {
+	type plain AddonZendesk
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonZendesk) MarshalJSON() ([]byte, error) {
+	type plain AddonZendesk
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// UnmarshalJSON decodes the modeled keys into their fields and the others into Unknown
+func (in *AddonZoom) UnmarshalJSON(data []byte) error {
+	type plain AddonZoom
+	return unmarshalKeepingUnknown(data, (*plain)(in), &in.Unknown)
+}
+
+// MarshalJSON encodes the modeled fields together with Unknown
+func (in AddonZoom) MarshalJSON() ([]byte, error) {
+	type plain AddonZoom
+	return marshalWithUnknown(plain(in), in.Unknown)
+}
+
+// unmarshalKeepingUnknown unmarshals data into v, a pointer to a struct, and the keys of data that the struct has no
+// JSON field for into unknown.
+func unmarshalKeepingUnknown(data []byte, v interface{}, unknown *map[string]runtime.RawExtension) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+
+	fields := jsonFields(reflect.TypeOf(v).Elem())
+	*unknown = nil
+	for key, value := range all {
+		if _, modeled := fields[key]; modeled {
+			continue
+		}
+		if *unknown == nil {
+			*unknown = map[string]runtime.RawExtension{}
+		}
+		(*unknown)[key] = runtime.RawExtension{Raw: value}
+	}
+	return nil
+}
+
+// marshalWithUnknown marshals v, a struct, and adds the keys of unknown that it does not set.
+func marshalWithUnknown(v interface{}, unknown map[string]runtime.RawExtension) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return data, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for key, value := range unknown {
+		if _, set := all[key]; !set {
+			all[key] = value.Raw
+		}
+	}
+	return json.Marshal(all)
+}
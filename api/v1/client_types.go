@@ -30,7 +30,24 @@ type A0ClientList struct {
 type A0ClientSpec struct {
 	// Policy defines the allowed operations for this client
 	// +kubebuilder:validation:Optional
-	Policy []V1EntityPolicyType `json:"policy,omitempty"`
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:rule="self.all(p, self.exists_one(q, q == p))",message="policy entries must be unique"
+	Policy []V1EntityPolicyType `json:"policy"`
+
+	// Mode defines whether the operator applies the configuration of this client, only plans the changes or only
+	// observes the client. Defaults to Apply.
+	// +kubebuilder:validation:Optional
+	Mode V1ReconcileMode `json:"mode,omitempty"`
+
+	// DriftPolicy defines how the operator handles changes made to the client outside of the operator, e.g. in the
+	// Auth0 dashboard. Defaults to Correct.
+	// +kubebuilder:validation:Optional
+	DriftPolicy V1DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// IgnoreFields are JSON paths into Conf, e.g. "logo_uri" or "jwt_configuration.lifetime_in_seconds", of the
+	// fields the operator neither compares with Auth0 nor updates
+	// +kubebuilder:validation:Optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 
 	// TenantRef is a reference to the A0Tenant this client belongs to
 	// +kubebuilder:validation:Required
@@ -63,9 +80,37 @@ type A0ClientStatus struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	LastConf *runtime.RawExtension `json:"lastConf,omitempty"`
+
+	// DriftBaseline contains LastConf with the last applied values of the fields that drifted in Auth0 and that the
+	// drift policy leaves as they are. It is unset when no field has drifted
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	DriftBaseline *runtime.RawExtension `json:"driftBaseline,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the last time the client was synchronized with Auth0
+	// +kubebuilder:validation:Optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Plan contains the changes the operator would make to the client in Plan mode
+	// +kubebuilder:validation:Optional
+	Plan *V1Plan `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the client
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // ClientFind specifies how to find an existing client in Auth0
+// +kubebuilder:validation:XValidation:rule="!(has(self.client_id) && has(self.callback_urls))",message="client_id and callback_urls are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.callback_url_match_mode) || has(self.callback_urls)",message="callback_url_match_mode requires callback_urls"
 type ClientFind struct {
 	// ClientId is the Auth0 client ID to search for
 	// +kubebuilder:validation:Optional
@@ -77,6 +122,7 @@ type ClientFind struct {
 
 	// CallbackUrlMatchMode defines how to match callback URLs
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=strict;loose
 	CallbackUrlMatchMode *string `json:"callback_url_match_mode,omitempty"`
 }
 
@@ -106,8 +152,7 @@ type ClientConf struct {
 
 	// AddOns configuration for third-party integrations
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:pruning:PreserveUnknownFields
-	AddOns *runtime.RawExtension `json:"addons,omitempty"`
+	AddOns *ClientAddons `json:"addons,omitempty"`
 
 	// AllowedClients lists allowed client IDs for this client
 	// +kubebuilder:validation:Optional
@@ -396,6 +441,7 @@ type OidcLogoutConfig struct {
 }
 
 // BackchannelLogoutInitiators contains backchannel logout initiator configuration
+// +kubebuilder:validation:XValidation:rule="!has(self.selected_initiators) || (has(self.mode) && self.mode == 'custom')",message="selected_initiators may only be set when mode is custom"
 type BackchannelLogoutInitiators struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=all;custom
@@ -407,6 +453,7 @@ type BackchannelLogoutInitiators struct {
 }
 
 // RefreshToken contains refresh token configuration
+// +kubebuilder:validation:XValidation:rule="!has(self.idle_token_lifetime) || !has(self.token_lifetime) || self.idle_token_lifetime <= self.token_lifetime",message="idle_token_lifetime must not exceed token_lifetime"
 type RefreshToken struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=rotating;non-rotating
@@ -450,3 +497,465 @@ type DefaultOrganization struct {
 	// +kubebuilder:validation:Enum=client_credentials
 	Flows []string `json:"flows,omitempty"`
 }
+
+// ClientAddons contains the configuration of the add-ons enabled for a client
+// +kubebuilder:pruning:PreserveUnknownFields
+type ClientAddons struct {
+	// AmazonWebServices configures the Amazon Web Services add-on
+	// +kubebuilder:validation:Optional
+	AmazonWebServices *AddonAws `json:"aws,omitempty"`
+
+	// AzureMobileServices configures the Windows Azure Mobile Services add-on
+	// +kubebuilder:validation:Optional
+	AzureMobileServices *AddonAzureMobileServices `json:"wams,omitempty"`
+
+	// AzureServiceBus configures the Windows Azure Service Bus add-on
+	// +kubebuilder:validation:Optional
+	AzureServiceBus *AddonAzureServiceBus `json:"azure_sb,omitempty"`
+
+	// Box enables the Box add-on
+	// +kubebuilder:validation:Optional
+	Box *AddonEnabled `json:"box,omitempty"`
+
+	// CloudBees enables the CloudBees add-on
+	// +kubebuilder:validation:Optional
+	CloudBees *AddonEnabled `json:"cloudbees,omitempty"`
+
+	// Concur enables the Concur add-on
+	// +kubebuilder:validation:Optional
+	Concur *AddonEnabled `json:"concur,omitempty"`
+
+	// DropBox enables the Dropbox add-on
+	// +kubebuilder:validation:Optional
+	DropBox *AddonEnabled `json:"dropbox,omitempty"`
+
+	// EchoSign configures the Adobe EchoSign add-on
+	// +kubebuilder:validation:Optional
+	EchoSign *AddonEchoSign `json:"echosign,omitempty"`
+
+	// Egnyte configures the Egnyte add-on
+	// +kubebuilder:validation:Optional
+	Egnyte *AddonEgnyte `json:"egnyte,omitempty"`
+
+	// FireBase configures the Google Firebase add-on
+	// +kubebuilder:validation:Optional
+	FireBase *AddonFirebase `json:"firebase,omitempty"`
+
+	// NewRelic configures the New Relic add-on
+	// +kubebuilder:validation:Optional
+	NewRelic *AddonNewRelic `json:"newrelic,omitempty"`
+
+	// Office365 configures the Microsoft Office 365 add-on
+	// +kubebuilder:validation:Optional
+	Office365 *AddonOffice365 `json:"office365,omitempty"`
+
+	// SalesForce configures the Salesforce SSO add-on
+	// +kubebuilder:validation:Optional
+	SalesForce *AddonSalesForce `json:"salesforce,omitempty"`
+
+	// SalesForceApi configures the Salesforce API add-on
+	// +kubebuilder:validation:Optional
+	SalesForceApi *AddonSalesForceApi `json:"salesforce_api,omitempty"`
+
+	// SalesForceSandboxApi configures the Salesforce Sandbox API add-on
+	// +kubebuilder:validation:Optional
+	SalesForceSandboxApi *AddonSalesForceApi `json:"salesforce_sandbox_api,omitempty"`
+
+	// SamlP configures the SAML2 Web App add-on
+	// +kubebuilder:validation:Optional
+	SamlP *AddonSamlp `json:"samlp,omitempty"`
+
+	// SapApi configures the SAP API add-on
+	// +kubebuilder:validation:Optional
+	SapApi *AddonSapApi `json:"sap_api,omitempty"`
+
+	// SharePoint configures the SharePoint add-on
+	// +kubebuilder:validation:Optional
+	SharePoint *AddonSharePoint `json:"sharepoint,omitempty"`
+
+	// SpringCM configures the SpringCM add-on
+	// +kubebuilder:validation:Optional
+	SpringCM *AddonSpringCM `json:"springcm,omitempty"`
+
+	// WebApi enables the Web API add-on
+	// +kubebuilder:validation:Optional
+	WebApi *AddonEnabled `json:"webapi,omitempty"`
+
+	// WsFed enables the WS-Federation add-on
+	// +kubebuilder:validation:Optional
+	WsFed *AddonEnabled `json:"wsfed,omitempty"`
+
+	// Zendesk configures the Zendesk add-on
+	// +kubebuilder:validation:Optional
+	Zendesk *AddonZendesk `json:"zendesk,omitempty"`
+
+	// Zoom configures the Zoom add-on
+	// +kubebuilder:validation:Optional
+	Zoom *AddonZoom `json:"zoom,omitempty"`
+
+	// Unknown holds the add-ons of the client that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonEnabled is an add-on whose settings are not modeled, enabled by its presence
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonEnabled struct {
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonAws contains AWS add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonAws struct {
+	// Principal is the AWS principal ARN
+	// +kubebuilder:validation:Optional
+	Principal *string `json:"principal,omitempty"`
+
+	// Role is the AWS role ARN
+	// +kubebuilder:validation:Optional
+	Role *string `json:"role,omitempty"`
+
+	// LifetimeInSeconds is the AWS token lifetime in seconds
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=43200
+	LifetimeInSeconds *int32 `json:"lifetime_in_seconds,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonAzureMobileServices contains Windows Azure Mobile Services add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonAzureMobileServices struct {
+	// MasterKey is the master key of the mobile service
+	// +kubebuilder:validation:Optional
+	MasterKey *string `json:"masterkey,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonAzureServiceBus contains Azure Service Bus add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonAzureServiceBus struct {
+	// Namespace is the Service Bus namespace
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// SasKeyName is the name of the shared access policy
+	// +kubebuilder:validation:Optional
+	SasKeyName *string `json:"sasKeyName,omitempty"`
+
+	// SasKey is the primary key of the shared access policy
+	// +kubebuilder:validation:Optional
+	SasKey *string `json:"sasKey,omitempty"`
+
+	// EntityPath is the path of the queue or topic the tokens are issued for
+	// +kubebuilder:validation:Optional
+	EntityPath *string `json:"entityPath,omitempty"`
+
+	// Expiration is the lifetime of the issued tokens
+	// +kubebuilder:validation:Optional
+	Expiration *string `json:"expiration,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonEchoSign contains Adobe EchoSign add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonEchoSign struct {
+	// Domain is the EchoSign domain of the account
+	// +kubebuilder:validation:Optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonEgnyte contains Egnyte add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonEgnyte struct {
+	// Domain is the Egnyte domain of the account
+	// +kubebuilder:validation:Optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonFirebase contains Google Firebase add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonFirebase struct {
+	// Secret is the Firebase secret, used with the legacy token format
+	// +kubebuilder:validation:Optional
+	Secret *string `json:"secret,omitempty"`
+
+	// PrivateKeyId is the ID of the service account private key
+	// +kubebuilder:validation:Optional
+	PrivateKeyId *string `json:"private_key_id,omitempty"`
+
+	// PrivateKey is the service account private key
+	// +kubebuilder:validation:Optional
+	PrivateKey *string `json:"private_key,omitempty"`
+
+	// ClientEmail is the service account client email
+	// +kubebuilder:validation:Optional
+	ClientEmail *string `json:"client_email,omitempty"`
+
+	// LifetimeInSeconds is the lifetime of the issued tokens in seconds
+	// +kubebuilder:validation:Optional
+	LifetimeInSeconds *int32 `json:"lifetime_in_seconds,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonNewRelic contains New Relic add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonNewRelic struct {
+	// Account is the New Relic account name
+	// +kubebuilder:validation:Optional
+	Account *string `json:"account,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonOffice365 contains Microsoft Office 365 add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonOffice365 struct {
+	// Domain is the Office 365 domain, e.g. example.com
+	// +kubebuilder:validation:Optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Connection is the name of the connection the users sign in with
+	// +kubebuilder:validation:Optional
+	Connection *string `json:"connection,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSalesForce contains Salesforce SSO add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSalesForce struct {
+	// EntityId is the entity ID of the Salesforce organization, e.g. https://example.my.salesforce.com
+	// +kubebuilder:validation:Optional
+	EntityId *string `json:"entity_id,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSalesForceApi contains Salesforce API add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSalesForceApi struct {
+	// ClientId is the consumer key of the Salesforce connected app
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"clientid,omitempty"`
+
+	// Principal is the name of the user the tokens are issued for
+	// +kubebuilder:validation:Optional
+	Principal *string `json:"principal,omitempty"`
+
+	// CommunityName is the name of the Salesforce community
+	// +kubebuilder:validation:Optional
+	CommunityName *string `json:"communityName,omitempty"`
+
+	// CommunityUrlSection is the URL section of the community
+	// +kubebuilder:validation:Optional
+	CommunityUrlSection *string `json:"community_url_section,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSamlp contains SAML2 Web App add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSamlp struct {
+	// Mappings maps user attributes to SAML attribute names
+	// +kubebuilder:validation:Optional
+	Mappings map[string]string `json:"mappings,omitempty"`
+
+	// Audience is the audience of the SAML assertion, the issuer of the service provider by default
+	// +kubebuilder:validation:Optional
+	Audience *string `json:"audience,omitempty"`
+
+	// Recipient is the recipient of the SAML assertion, the assertion consumer service URL by default
+	// +kubebuilder:validation:Optional
+	Recipient *string `json:"recipient,omitempty"`
+
+	// CreateUpnClaim adds a UPN claim built from the user's email
+	// +kubebuilder:validation:Optional
+	CreateUpnClaim *bool `json:"createUpnClaim,omitempty"`
+
+	// MapUnknownClaimsAsIs adds user attributes without a mapping to the assertion under their own name
+	// +kubebuilder:validation:Optional
+	MapUnknownClaimsAsIs *bool `json:"mapUnknownClaimsAsIs,omitempty"`
+
+	// PassthroughClaimsWithNoMapping adds user attributes without a mapping to the assertion
+	// +kubebuilder:validation:Optional
+	PassthroughClaimsWithNoMapping *bool `json:"passthroughClaimsWithNoMapping,omitempty"`
+
+	// MapIdentities adds the user's identities to the assertion
+	// +kubebuilder:validation:Optional
+	MapIdentities *bool `json:"mapIdentities,omitempty"`
+
+	// SignatureAlgorithm is the algorithm the response or assertion is signed with
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=rsa-sha1;rsa-sha256
+	SignatureAlgorithm *string `json:"signatureAlgorithm,omitempty"`
+
+	// DigestAlgorithm is the algorithm of the signature digest
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=sha1;sha256
+	DigestAlgorithm *string `json:"digestAlgorithm,omitempty"`
+
+	// Issuer is the issuer of the SAML assertion
+	// +kubebuilder:validation:Optional
+	Issuer *string `json:"issuer,omitempty"`
+
+	// Destination is the destination of the SAML response, the assertion consumer service URL by default
+	// +kubebuilder:validation:Optional
+	Destination *string `json:"destination,omitempty"`
+
+	// LifetimeInSeconds is the lifetime of the assertion in seconds
+	// +kubebuilder:validation:Optional
+	LifetimeInSeconds *int32 `json:"lifetimeInSeconds,omitempty"`
+
+	// SignResponse signs the SAML response instead of the assertion
+	// +kubebuilder:validation:Optional
+	SignResponse *bool `json:"signResponse,omitempty"`
+
+	// NameIdentifierFormat is the format of the name identifier
+	// +kubebuilder:validation:Optional
+	NameIdentifierFormat *string `json:"nameIdentifierFormat,omitempty"`
+
+	// NameIdentifierProbes are the user attributes tried in order to set the name identifier
+	// +kubebuilder:validation:Optional
+	NameIdentifierProbes []string `json:"nameIdentifierProbes,omitempty"`
+
+	// AuthnContextClassRef is the authentication context class reference of the assertion
+	// +kubebuilder:validation:Optional
+	AuthnContextClassRef *string `json:"authnContextClassRef,omitempty"`
+
+	// TypedAttributes sets the xs:type of the attributes from their values
+	// +kubebuilder:validation:Optional
+	TypedAttributes *bool `json:"typedAttributes,omitempty"`
+
+	// IncludeAttributeNameFormat adds the NameFormat of each attribute
+	// +kubebuilder:validation:Optional
+	IncludeAttributeNameFormat *bool `json:"includeAttributeNameFormat,omitempty"`
+
+	// Logout configures SAML single logout
+	// +kubebuilder:validation:Optional
+	Logout *AddonSamlpLogout `json:"logout,omitempty"`
+
+	// Binding is the protocol binding the SAML response is sent with
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST";"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	Binding *string `json:"binding,omitempty"`
+
+	// SigningCert is the certificate that signs the requests of the service provider, in PEM format
+	// +kubebuilder:validation:Optional
+	SigningCert *string `json:"signingCert,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSamlpLogout contains SAML2 Web App single logout configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSamlpLogout struct {
+	// Callback is the single logout URL of the service provider
+	// +kubebuilder:validation:Optional
+	Callback *string `json:"callback,omitempty"`
+
+	// SloEnabled enables single logout
+	// +kubebuilder:validation:Optional
+	SloEnabled *bool `json:"slo_enabled,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSapApi contains SAP API add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSapApi struct {
+	// ClientId is the OAuth2 client ID of the SAP API
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"clientid,omitempty"`
+
+	// UsernameAttribute is the user attribute sent as the SAP user name
+	// +kubebuilder:validation:Optional
+	UsernameAttribute *string `json:"usernameAttribute,omitempty"`
+
+	// TokenEndpointUrl is the OAuth2 token endpoint of the SAP API
+	// +kubebuilder:validation:Optional
+	TokenEndpointUrl *string `json:"tokenEndpointUrl,omitempty"`
+
+	// Scope is the scope requested from the SAP API
+	// +kubebuilder:validation:Optional
+	Scope *string `json:"scope,omitempty"`
+
+	// ServicePassword is the password of the SAP service user
+	// +kubebuilder:validation:Optional
+	ServicePassword *string `json:"servicePassword,omitempty"`
+
+	// NameIdentifierFormat is the format of the name identifier
+	// +kubebuilder:validation:Optional
+	NameIdentifierFormat *string `json:"nameIdentifierFormat,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSharePoint contains SharePoint add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSharePoint struct {
+	// Url is the internal URL of the SharePoint site
+	// +kubebuilder:validation:Optional
+	Url *string `json:"url,omitempty"`
+
+	// ExternalUrl are the external URLs of the SharePoint site
+	// +kubebuilder:validation:Optional
+	ExternalUrl []string `json:"external_url,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonSpringCM contains SpringCM add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonSpringCM struct {
+	// AcsUrl is the assertion consumer service URL of SpringCM
+	// +kubebuilder:validation:Optional
+	AcsUrl *string `json:"acsurl,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonZendesk contains Zendesk add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonZendesk struct {
+	// AccountName is the Zendesk account name
+	// +kubebuilder:validation:Optional
+	AccountName *string `json:"accountName,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
+
+// AddonZoom contains Zoom add-on configuration
+// +kubebuilder:pruning:PreserveUnknownFields
+type AddonZoom struct {
+	// Account is the Zoom account name
+	// +kubebuilder:validation:Optional
+	Account *string `json:"account,omitempty"`
+
+	// Unknown holds the settings of the add-on that this type does not model, keyed by their JSON name
+	Unknown map[string]runtime.RawExtension `json:"-"`
+}
@@ -1,5 +1,7 @@
 package v1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // V1EntityPolicyType defines the policy types for Auth0 entities
 // +kubebuilder:validation:Enum=Create;Update;Delete
 type V1EntityPolicyType string
@@ -13,6 +15,88 @@ const (
 	PolicyTypeDelete V1EntityPolicyType = "Delete"
 )
 
+// V1ReconcileMode defines how the operator reconciles an entity with Auth0
+// +kubebuilder:validation:Enum=Apply;Plan;Observe
+type V1ReconcileMode string
+
+const (
+	// ReconcileModeApply creates and updates the associated entity in the tenant as the policy allows
+	ReconcileModeApply V1ReconcileMode = "Apply"
+	// ReconcileModePlan records the changes the operator would make in the status without making them
+	ReconcileModePlan V1ReconcileMode = "Plan"
+	// ReconcileModeObserve only reads the associated entity from the tenant into the status
+	ReconcileModeObserve V1ReconcileMode = "Observe"
+)
+
+// V1DriftPolicy defines how the operator handles changes made to an entity outside of the operator
+// +kubebuilder:validation:Enum=Correct;Report;Ignore
+type V1DriftPolicy string
+
+const (
+	// DriftPolicyCorrect updates the fields that changed in Auth0 back to the configuration
+	DriftPolicyCorrect V1DriftPolicy = "Correct"
+	// DriftPolicyReport leaves the fields that changed in Auth0 as they are and reports them in the Drifted condition
+	DriftPolicyReport V1DriftPolicy = "Report"
+	// DriftPolicyIgnore leaves the fields that changed in Auth0 as they are without reporting them
+	DriftPolicyIgnore V1DriftPolicy = "Ignore"
+)
+
+// V1PlanAction defines what applying the configuration would do to an entity
+// +kubebuilder:validation:Enum=Create;Update;None
+type V1PlanAction string
+
+const (
+	// PlanActionCreate creates the entity, which was not found in the tenant
+	PlanActionCreate V1PlanAction = "Create"
+	// PlanActionUpdate updates the fields of the entity that differ from the configuration
+	PlanActionUpdate V1PlanAction = "Update"
+	// PlanActionNone leaves the entity unchanged
+	PlanActionNone V1PlanAction = "None"
+)
+
+// V1Plan represents the changes the operator would make to an entity in Plan mode
+type V1Plan struct {
+	// Action is what applying the configuration would do to the entity
+	// +kubebuilder:validation:Required
+	Action V1PlanAction `json:"action"`
+
+	// Changes are the fields that applying the configuration would change
+	// +optional
+	Changes []V1PlanChange `json:"changes,omitempty"`
+
+	// Message explains why the configuration would not be applied, e.g. a policy without Update
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the generation of the resource the plan was computed for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// PlanTime is the time the plan was computed
+	// +optional
+	PlanTime *metav1.Time `json:"planTime,omitempty"`
+}
+
+// V1PlanChange represents a field that applying the configuration would change
+type V1PlanChange struct {
+	// Path is the JSON path of the field, e.g. "jwt_configuration.alg" or "callbacks[2]"
+	// +kubebuilder:validation:Required
+	Path string `json:"path"`
+
+	// Type is how the field changes
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Added;Modified;Removed
+	Type string `json:"type"`
+
+	// Desired is the JSON encoded value of the configuration, unset for Removed fields
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Live is the JSON encoded value of the entity in Auth0, unset for Added fields
+	// +optional
+	Live string `json:"live,omitempty"`
+}
+
 // V1TenantReference represents a reference to an A0Tenant resource
 type V1TenantReference struct {
 	// Namespace is the namespace of the referenced tenant.
@@ -37,6 +121,7 @@ type V1SecretReference struct {
 }
 
 // V1ConnectionReference represents a reference to an A0Connection resource
+// +kubebuilder:validation:XValidation:rule="!(has(self.name) && has(self.id))",message="at most one of name or id may be set"
 type V1ConnectionReference struct {
 	// Namespace is the namespace of the referenced connection.
 	// If empty, the same namespace as the referencing resource is assumed.
@@ -53,7 +138,21 @@ type V1ConnectionReference struct {
 }
 
 // V1ResourceServerReference represents a reference to an A0ResourceServer resource
+// +kubebuilder:validation:XValidation:rule="!(has(self.name) && has(self.id))",message="at most one of name or id may be set"
 type V1ResourceServerReference struct {
+	// Namespace is the namespace of the referenced resource server.
+	// If empty, the same namespace as the referencing resource is assumed.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Name is the name of the referenced resource server
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Id is the Auth0 ID of the resource server
+	// +optional
+	Id *string `json:"id,omitempty"`
+
 	// Identifier is the identifier of the resource server
 	// +optional
 	Identifier *string `json:"identifier,omitempty"`
@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types reported on the status of A0* resources
+const (
+	// ConditionTypeReady indicates that the entity exists in Auth0 and matches the desired configuration
+	ConditionTypeReady = "Ready"
+	// ConditionTypeSynced indicates that the last reconciliation with Auth0 succeeded
+	ConditionTypeSynced = "Synced"
+	// ConditionTypeDrifted indicates that the entity in Auth0 differs from the last applied configuration
+	ConditionTypeDrifted = "Drifted"
+	// ConditionTypeDegraded indicates that reconciliation is failing or only partially applied
+	ConditionTypeDegraded = "Degraded"
+)
+
+// Common condition reasons reported on the status of A0* resources
+const (
+	ReasonReconciled           = "Reconciled"
+	ReasonReconcileFailed      = "ReconcileFailed"
+	ReasonTenantNotFound       = "TenantNotFound"
+	ReasonDependencyNotReady   = "DependencyNotReady"
+	ReasonRateLimited          = "RateLimited"
+	ReasonDriftDetected        = "DriftDetected"
+	ReasonNoDrift              = "NoDrift"
+	ReasonPolicyDenied         = "PolicyDenied"
+	ReasonInvalidConfiguration = "InvalidConfiguration"
+	ReasonPlanned              = "Planned"
+	ReasonObserved             = "Observed"
+)
+
+// ConditionsAccessor is implemented by the A0* resources to expose their status conditions
+// +kubebuilder:object:generate=false
+type ConditionsAccessor interface {
+	metav1.Object
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// SyncStatusAccessor is implemented by the A0* resources to expose the synchronization fields of their status
+// +kubebuilder:object:generate=false
+type SyncStatusAccessor interface {
+	ConditionsAccessor
+	GetObservedGeneration() int64
+	SetObservedGeneration(generation int64)
+	GetLastSyncTime() *metav1.Time
+	SetLastSyncTime(t *metav1.Time)
+}
+
+// SetCondition adds or updates the condition of the given type on obj.
+// The LastTransitionTime is only changed when the condition status changes, and
+// ObservedGeneration defaults to the generation of obj.
+func SetCondition(obj ConditionsAccessor, condition metav1.Condition) {
+	if condition.ObservedGeneration == 0 {
+		condition.ObservedGeneration = obj.GetGeneration()
+	}
+	conditions := obj.GetConditions()
+	meta.SetStatusCondition(&conditions, condition)
+	obj.SetConditions(conditions)
+}
+
+// RemoveCondition removes the condition of the given type from obj.
+func RemoveCondition(obj ConditionsAccessor, conditionType string) {
+	conditions := obj.GetConditions()
+	meta.RemoveStatusCondition(&conditions, conditionType)
+	obj.SetConditions(conditions)
+}
+
+// GetCondition returns the condition of the given type on obj, or nil if it is not present.
+func GetCondition(obj ConditionsAccessor, conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(obj.GetConditions(), conditionType)
+}
+
+// IsConditionTrue reports whether the condition of the given type on obj has status True.
+func IsConditionTrue(obj ConditionsAccessor, conditionType string) bool {
+	return meta.IsStatusConditionTrue(obj.GetConditions(), conditionType)
+}
+
+// IsConditionFalse reports whether the condition of the given type on obj has status False.
+func IsConditionFalse(obj ConditionsAccessor, conditionType string) bool {
+	return meta.IsStatusConditionFalse(obj.GetConditions(), conditionType)
+}
+
+// IsReady reports whether obj has a Ready condition with status True that reflects its current generation.
+func IsReady(obj ConditionsAccessor) bool {
+	c := GetCondition(obj, ConditionTypeReady)
+	return c != nil && c.Status == metav1.ConditionTrue && c.ObservedGeneration == obj.GetGeneration()
+}
+
+// GetConditions returns the status conditions of the client
+func (in *A0Client) GetConditions() []metav1.Condition { return in.Status.Conditions }
+
+// SetConditions replaces the status conditions of the client
+func (in *A0Client) SetConditions(conditions []metav1.Condition) { in.Status.Conditions = conditions }
+
+// GetConditions returns the status conditions of the connection
+func (in *A0Connection) GetConditions() []metav1.Condition { return in.Status.Conditions }
+
+// SetConditions replaces the status conditions of the connection
+func (in *A0Connection) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the client grant
+func (in *A0ClientGrant) GetConditions() []metav1.Condition { return in.Status.Conditions }
+
+// SetConditions replaces the status conditions of the client grant
+func (in *A0ClientGrant) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the resource server
+func (in *A0ResourceServer) GetConditions() []metav1.Condition { return in.Status.Conditions }
+
+// SetConditions replaces the status conditions of the resource server
+func (in *A0ResourceServer) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the tenant
+func (in *A0Tenant) GetConditions() []metav1.Condition { return in.Status.Conditions }
+
+// SetConditions replaces the status conditions of the tenant
+func (in *A0Tenant) SetConditions(conditions []metav1.Condition) { in.Status.Conditions = conditions }
+
+// GetObservedGeneration returns the generation last observed by the operator
+func (in *A0Client) GetObservedGeneration() int64 { return in.Status.ObservedGeneration }
+
+// SetObservedGeneration records the generation last observed by the operator
+func (in *A0Client) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// GetLastSyncTime returns the last time the client was synchronized with Auth0
+func (in *A0Client) GetLastSyncTime() *metav1.Time { return in.Status.LastSyncTime }
+
+// SetLastSyncTime records the last time the client was synchronized with Auth0
+func (in *A0Client) SetLastSyncTime(t *metav1.Time) { in.Status.LastSyncTime = t }
+
+// GetObservedGeneration returns the generation last observed by the operator
+func (in *A0Connection) GetObservedGeneration() int64 { return in.Status.ObservedGeneration }
+
+// SetObservedGeneration records the generation last observed by the operator
+func (in *A0Connection) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// GetLastSyncTime returns the last time the connection was synchronized with Auth0
+func (in *A0Connection) GetLastSyncTime() *metav1.Time { return in.Status.LastSyncTime }
+
+// SetLastSyncTime records the last time the connection was synchronized with Auth0
+func (in *A0Connection) SetLastSyncTime(t *metav1.Time) { in.Status.LastSyncTime = t }
+
+// GetObservedGeneration returns the generation last observed by the operator
+func (in *A0ClientGrant) GetObservedGeneration() int64 { return in.Status.ObservedGeneration }
+
+// SetObservedGeneration records the generation last observed by the operator
+func (in *A0ClientGrant) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// GetLastSyncTime returns the last time the client grant was synchronized with Auth0
+func (in *A0ClientGrant) GetLastSyncTime() *metav1.Time { return in.Status.LastSyncTime }
+
+// SetLastSyncTime records the last time the client grant was synchronized with Auth0
+func (in *A0ClientGrant) SetLastSyncTime(t *metav1.Time) { in.Status.LastSyncTime = t }
+
+// GetObservedGeneration returns the generation last observed by the operator
+func (in *A0ResourceServer) GetObservedGeneration() int64 { return in.Status.ObservedGeneration }
+
+// SetObservedGeneration records the generation last observed by the operator
+func (in *A0ResourceServer) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// GetLastSyncTime returns the last time the resource server was synchronized with Auth0
+func (in *A0ResourceServer) GetLastSyncTime() *metav1.Time { return in.Status.LastSyncTime }
+
+// SetLastSyncTime records the last time the resource server was synchronized with Auth0
+func (in *A0ResourceServer) SetLastSyncTime(t *metav1.Time) { in.Status.LastSyncTime = t }
+
+// GetObservedGeneration returns the generation last observed by the operator
+func (in *A0Tenant) GetObservedGeneration() int64 { return in.Status.ObservedGeneration }
+
+// SetObservedGeneration records the generation last observed by the operator
+func (in *A0Tenant) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// GetLastSyncTime returns the last time the tenant was synchronized with Auth0
+func (in *A0Tenant) GetLastSyncTime() *metav1.Time { return in.Status.LastSyncTime }
+
+// SetLastSyncTime records the last time the tenant was synchronized with Auth0
+func (in *A0Tenant) SetLastSyncTime(t *metav1.Time) { in.Status.LastSyncTime = t }
+
+var (
+	_ SyncStatusAccessor = &A0Client{}
+	_ SyncStatusAccessor = &A0Connection{}
+	_ SyncStatusAccessor = &A0ClientGrant{}
+	_ SyncStatusAccessor = &A0ResourceServer{}
+	_ SyncStatusAccessor = &A0Tenant{}
+)
@@ -0,0 +1,120 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestSetCondition(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	ready := func(status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: v1.ConditionTypeReady, Status: status, Reason: reason, Message: reason, LastTransitionTime: earlier}
+	}
+
+	for _, tc := range []struct {
+		name           string
+		existing       []metav1.Condition
+		set            metav1.Condition
+		wantReason     string
+		wantTransition bool
+		wantCount      int
+	}{
+		{
+			name:           "adds a missing condition",
+			set:            ready(metav1.ConditionTrue, v1.ReasonReconciled),
+			wantReason:     v1.ReasonReconciled,
+			wantTransition: false,
+			wantCount:      1,
+		},
+		{
+			name:           "keeps the transition time when the status is unchanged",
+			existing:       []metav1.Condition{ready(metav1.ConditionTrue, v1.ReasonReconciled)},
+			set:            metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: v1.ReasonNoDrift},
+			wantReason:     v1.ReasonNoDrift,
+			wantTransition: false,
+			wantCount:      1,
+		},
+		{
+			name:           "updates the transition time when the status changes",
+			existing:       []metav1.Condition{ready(metav1.ConditionTrue, v1.ReasonReconciled)},
+			set:            metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionFalse, Reason: v1.ReasonReconcileFailed},
+			wantReason:     v1.ReasonReconcileFailed,
+			wantTransition: true,
+			wantCount:      1,
+		},
+		{
+			name: "leaves other conditions alone",
+			existing: []metav1.Condition{
+				{Type: v1.ConditionTypeSynced, Status: metav1.ConditionTrue, Reason: v1.ReasonReconciled, LastTransitionTime: earlier},
+			},
+			set:            ready(metav1.ConditionTrue, v1.ReasonReconciled),
+			wantReason:     v1.ReasonReconciled,
+			wantTransition: false,
+			wantCount:      2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &v1.A0Client{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+			obj.SetConditions(tc.existing)
+
+			v1.SetCondition(obj, tc.set)
+
+			c := v1.GetCondition(obj, v1.ConditionTypeReady)
+			if c == nil {
+				t.Fatal("expected a Ready condition")
+			}
+			if c.Reason != tc.wantReason || c.Status != tc.set.Status {
+				t.Errorf("got %s/%s, want %s/%s", c.Status, c.Reason, tc.set.Status, tc.wantReason)
+			}
+			if transitioned := !c.LastTransitionTime.Equal(&earlier); transitioned != tc.wantTransition {
+				t.Errorf("LastTransitionTime = %v, want a transition: %v", c.LastTransitionTime, tc.wantTransition)
+			}
+			if c.ObservedGeneration != 3 {
+				t.Errorf("ObservedGeneration = %d, want the generation of the object", c.ObservedGeneration)
+			}
+			if n := len(obj.GetConditions()); n != tc.wantCount {
+				t.Errorf("got %d conditions, want %d", n, tc.wantCount)
+			}
+		})
+	}
+}
+
+func TestRemoveCondition(t *testing.T) {
+	obj := &v1.A0Connection{}
+	v1.SetCondition(obj, metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: v1.ReasonReconciled})
+	v1.SetCondition(obj, metav1.Condition{Type: v1.ConditionTypeDrifted, Status: metav1.ConditionTrue, Reason: v1.ReasonDriftDetected})
+
+	v1.RemoveCondition(obj, v1.ConditionTypeDrifted)
+	v1.RemoveCondition(obj, v1.ConditionTypeDegraded)
+
+	if v1.GetCondition(obj, v1.ConditionTypeDrifted) != nil {
+		t.Error("expected the Drifted condition to be removed")
+	}
+	if !v1.IsConditionTrue(obj, v1.ConditionTypeReady) {
+		t.Error("expected the Ready condition to be kept")
+	}
+	if v1.IsConditionFalse(obj, v1.ConditionTypeDrifted) {
+		t.Error("expected a removed condition to be neither true nor false")
+	}
+}
+
+func TestIsReady(t *testing.T) {
+	obj := &v1.A0ResourceServer{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	if v1.IsReady(obj) {
+		t.Error("expected an object without conditions not to be ready")
+	}
+
+	v1.SetCondition(obj, metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: v1.ReasonReconciled})
+	if !v1.IsReady(obj) {
+		t.Error("expected the object to be ready")
+	}
+
+	obj.Generation = 2
+	if v1.IsReady(obj) {
+		t.Error("expected a Ready condition of an older generation not to count")
+	}
+}
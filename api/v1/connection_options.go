@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GetOptions decodes Options into a ConnectionOptions.
+// It returns nil if no options are set.
+func (c *ConnectionConf) GetOptions() (*ConnectionOptions, error) {
+	opts := &ConnectionOptions{}
+	ok, err := decodeOptions(c.Options, opts)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// SetOptions encodes opts into Options. Keys already present in Options that
+// ConnectionOptions does not model are retained, so that decoding and encoding
+// the options does not lose settings unknown to this package.
+// Passing nil clears Options.
+func (c *ConnectionConf) SetOptions(opts *ConnectionOptions) error {
+	if opts == nil {
+		c.Options = nil
+		return nil
+	}
+	raw, err := encodeOptions(c.Options, opts)
+	if err != nil {
+		return err
+	}
+	c.Options = raw
+	return nil
+}
+
+// decodeOptions unmarshals raw into out. It reports false if raw holds no value.
+func decodeOptions(raw *runtime.RawExtension, out interface{}) (bool, error) {
+	if raw == nil || len(raw.Raw) == 0 || string(raw.Raw) == "null" {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw.Raw, out); err != nil {
+		return false, fmt.Errorf("failed to decode connection options: %w", err)
+	}
+	return true, nil
+}
+
+// encodeOptions marshals opts and merges in the keys of existing that the type of opts does not model.
+func encodeOptions(existing *runtime.RawExtension, opts interface{}) (*runtime.RawExtension, error) {
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode connection options: %w", err)
+	}
+
+	if existing == nil || len(existing.Raw) == 0 {
+		return &runtime.RawExtension{Raw: data}, nil
+	}
+
+	var prev map[string]interface{}
+	if err := json.Unmarshal(existing.Raw, &prev); err != nil || prev == nil {
+		// the existing options are not an object, so there is nothing to retain
+		return &runtime.RawExtension{Raw: data}, nil
+	}
+
+	var next map[string]interface{}
+	if err := json.Unmarshal(data, &next); err != nil {
+		return nil, fmt.Errorf("failed to encode connection options: %w", err)
+	}
+
+	merged, err := json.Marshal(mergeUnmodeled(prev, next, reflect.TypeOf(opts)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode connection options: %w", err)
+	}
+	return &runtime.RawExtension{Raw: merged}, nil
+}
+
+// mergeUnmodeled copies into next every key of prev that has no corresponding JSON field on t,
+// descending into nested objects that are modeled as structs.
+func mergeUnmodeled(prev, next map[string]interface{}, t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if next == nil {
+		next = map[string]interface{}{}
+	}
+
+	fields := jsonFields(t)
+	for key, value := range prev {
+		field, modeled := fields[key]
+		if !modeled {
+			next[key] = value
+			continue
+		}
+
+		// recurse into nested objects and lists of objects that are still present in the new value
+		if v, ok := next[key]; ok {
+			next[key] = mergeUnmodeledValue(value, v, field.Type)
+		}
+	}
+
+	return next
+}
+
+// mergeUnmodeledValue merges the unmodeled keys of prev into next, values of the Go type t. Elements of lists are
+// matched by their index.
+func mergeUnmodeledValue(prev, next interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		prevObj, prevIsObj := prev.(map[string]interface{})
+		nextObj, nextIsObj := next.(map[string]interface{})
+		if prevIsObj && nextIsObj {
+			return mergeUnmodeled(prevObj, nextObj, t)
+		}
+	case reflect.Slice, reflect.Array:
+		prevList, prevIsList := prev.([]interface{})
+		nextList, nextIsList := next.([]interface{})
+		if prevIsList && nextIsList {
+			for i := range nextList {
+				if i < len(prevList) {
+					nextList[i] = mergeUnmodeledValue(prevList[i], nextList[i], t.Elem())
+				}
+			}
+			return nextList
+		}
+	}
+	return next
+}
+
+// jsonFields returns the fields of struct type t indexed by their JSON name.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestConnectionOptionsRoundTrip(t *testing.T) {
+	wire := `{
+		"brute_force_protection": true,
+		"validation": {"username": {"min": 1, "max": 15, "allow_unicode": true}},
+		"precedence": ["email", "username"],
+		"upstream_params": {"screen_hint": {"value": "signup"}}
+	}`
+	conf := &ConnectionConf{Options: &runtime.RawExtension{Raw: []byte(wire)}}
+
+	opts, err := conf.GetOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conf.SetOptions(opts); err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, conf.Options.Raw, wire)
+
+	// changing a modeled field keeps the unmodeled keys next to it
+	opts.Validation.UserName.Max = int32Ptr(20)
+	if err := conf.SetOptions(opts); err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, conf.Options.Raw, `{
+		"brute_force_protection": true,
+		"validation": {"username": {"min": 1, "max": 20, "allow_unicode": true}},
+		"precedence": ["email", "username"],
+		"upstream_params": {"screen_hint": {"value": "signup"}}
+	}`)
+}
+
+func TestEncodeOptionsKeepsUnmodeledKeysInLists(t *testing.T) {
+	type mapping struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	type options struct {
+		Mappings []mapping `json:"mappings"`
+	}
+	existing := &runtime.RawExtension{Raw: []byte(`{
+		"mappings": [{"name": "email", "value": "mail", "required": true}, {"name": "name", "value": "cn"}],
+		"sign_in_endpoint": "https://idp.example.com/sso"
+	}`)}
+
+	var opts options
+	if _, err := decodeOptions(existing, &opts); err != nil {
+		t.Fatal(err)
+	}
+	opts.Mappings[1].Value = "displayName"
+	opts.Mappings = append(opts.Mappings, mapping{Name: "groups", Value: "memberOf"})
+
+	raw, err := encodeOptions(existing, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, raw.Raw, `{
+		"mappings": [
+			{"name": "email", "value": "mail", "required": true},
+			{"name": "name", "value": "displayName"},
+			{"name": "groups", "value": "memberOf"}
+		],
+		"sign_in_endpoint": "https://idp.example.com/sso"
+	}`)
+}
+
+func assertJSONEqual(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func int32Ptr(i int32) *int32 { return &i }
@@ -0,0 +1,346 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConnectionOptions represents the options of a connection using the auth0 (database) strategy.
+// It mirrors the C# ConnectionOptions model and is carried on the wire in ConnectionConf.Options.
+type ConnectionOptions struct {
+	// Validation contains validation rules for user attributes
+	// +kubebuilder:validation:Optional
+	Validation *ConnectionOptionsValidation `json:"validation,omitempty"`
+
+	// NonPersistentAttributes lists user attributes that are not persisted
+	// +kubebuilder:validation:Optional
+	NonPersistentAttributes []string `json:"non_persistent_attrs,omitempty"`
+
+	// Precedence defines the order in which user identifiers are evaluated
+	// +kubebuilder:validation:Optional
+	Precedence []ConnectionOptionsPrecedence `json:"precedence,omitempty"`
+
+	// Attributes contains the identifier attribute configuration
+	// +kubebuilder:validation:Optional
+	Attributes *ConnectionOptionsAttributes `json:"attributes,omitempty"`
+
+	// EnableScriptContext enables the script context for custom database scripts
+	// +kubebuilder:validation:Optional
+	EnableScriptContext *bool `json:"enable_script_context,omitempty"`
+
+	// EnableDatabaseCustomization enables custom database scripts
+	// +kubebuilder:validation:Optional
+	EnableDatabaseCustomization *bool `json:"enabledDatabaseCustomization,omitempty"`
+
+	// ImportMode enables lazy migration of users from a custom database
+	// +kubebuilder:validation:Optional
+	ImportMode *bool `json:"import_mode,omitempty"`
+
+	// CustomScripts contains the custom database action scripts
+	// +kubebuilder:validation:Optional
+	CustomScripts *ConnectionOptionsCustomScripts `json:"customScripts,omitempty"`
+
+	// AuthenticationMethods contains the enabled authentication methods
+	// +kubebuilder:validation:Optional
+	AuthenticationMethods *ConnectionOptionsAuthenticationMethods `json:"authentication_methods,omitempty"`
+
+	// PasskeyOptions contains passkey configuration
+	// +kubebuilder:validation:Optional
+	PasskeyOptions *ConnectionOptionsPasskeyOptions `json:"passkey_options,omitempty"`
+
+	// PasswordPolicy specifies the password strength level
+	// +kubebuilder:validation:Optional
+	PasswordPolicy *ConnectionOptionsPasswordPolicy `json:"passwordPolicy,omitempty"`
+
+	// PasswordComplexityOptions contains password complexity configuration
+	// +kubebuilder:validation:Optional
+	PasswordComplexityOptions *ConnectionOptionsPasswordComplexityOptions `json:"password_complexity_options,omitempty"`
+
+	// PasswordHistory contains password history configuration
+	// +kubebuilder:validation:Optional
+	PasswordHistory *ConnectionOptionsPasswordHistory `json:"password_history,omitempty"`
+
+	// PasswordNoPersonalInfo contains personal information restriction configuration
+	// +kubebuilder:validation:Optional
+	PasswordNoPersonalInfo *ConnectionOptionsPasswordNoPersonalInfo `json:"password_no_personal_info,omitempty"`
+
+	// PasswordDictionary contains password dictionary configuration
+	// +kubebuilder:validation:Optional
+	PasswordDictionary *ConnectionOptionsPasswordDictionary `json:"password_dictionary,omitempty"`
+
+	// ApiEnableUsers enables the users API
+	// +kubebuilder:validation:Optional
+	ApiEnableUsers *bool `json:"api_enable_users,omitempty"`
+
+	// BasicProfile requests the basic profile
+	// +kubebuilder:validation:Optional
+	BasicProfile *bool `json:"basic_profile,omitempty"`
+
+	// ExtAdmin requests the admin extended attribute
+	// +kubebuilder:validation:Optional
+	ExtAdmin *bool `json:"ext_admin,omitempty"`
+
+	// ExtIsSuspended requests the is_suspended extended attribute
+	// +kubebuilder:validation:Optional
+	ExtIsSuspended *bool `json:"ext_is_suspended,omitempty"`
+
+	// ExtAgreedTerms requests the agreed_terms extended attribute
+	// +kubebuilder:validation:Optional
+	ExtAgreedTerms *bool `json:"ext_agreed_terms,omitempty"`
+
+	// ExtGroups requests the groups extended attribute
+	// +kubebuilder:validation:Optional
+	ExtGroups *bool `json:"ext_groups,omitempty"`
+
+	// ExtAssignedPlans requests the assigned_plans extended attribute
+	// +kubebuilder:validation:Optional
+	ExtAssignedPlans *bool `json:"ext_assigned_plans,omitempty"`
+
+	// ExtProfile requests the profile extended attribute
+	// +kubebuilder:validation:Optional
+	ExtProfile *bool `json:"ext_profile,omitempty"`
+
+	// DisableSelfServiceChangePassword disables self-service password changes
+	// +kubebuilder:validation:Optional
+	DisableSelfServiceChangePassword *bool `json:"disable_self_service_change_password,omitempty"`
+
+	// UpstreamParams contains parameters passed to the upstream identity provider
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UpstreamParams *runtime.RawExtension `json:"upstream_params,omitempty"`
+
+	// SetUserRootAttributes specifies when root user attributes are updated
+	// +kubebuilder:validation:Optional
+	SetUserRootAttributes *SetUserRootAttributes `json:"set_user_root_attributes,omitempty"`
+
+	// GatewayAuthentication contains gateway authentication configuration
+	// +kubebuilder:validation:Optional
+	GatewayAuthentication *GatewayAuthentication `json:"gateway_authentication,omitempty"`
+}
+
+// ConnectionOptionsValidation contains validation rules for user attributes
+type ConnectionOptionsValidation struct {
+	// +kubebuilder:validation:Optional
+	UserName *ConnectionOptionsUserName `json:"username,omitempty"`
+}
+
+// ConnectionOptionsUserName contains username length restrictions
+type ConnectionOptionsUserName struct {
+	// +kubebuilder:validation:Optional
+	Min *int32 `json:"min,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Max *int32 `json:"max,omitempty"`
+}
+
+// ConnectionOptionsAttributes contains the identifier attribute configuration
+type ConnectionOptionsAttributes struct {
+	// +kubebuilder:validation:Optional
+	Email *ConnectionOptionsEmailAttribute `json:"email,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PhoneNumber *ConnectionOptionsPhoneNumberAttribute `json:"phone_number,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Username *ConnectionOptionsUsernameAttribute `json:"username,omitempty"`
+}
+
+// ConnectionOptionsAttributeIdentifier indicates whether an attribute can be used as an identifier
+type ConnectionOptionsAttributeIdentifier struct {
+	// +kubebuilder:validation:Optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// ConnectionOptionsVerification indicates whether an attribute must be verified
+type ConnectionOptionsVerification struct {
+	// +kubebuilder:validation:Optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// ConnectionOptionsEmailAttribute contains email attribute configuration
+type ConnectionOptionsEmailAttribute struct {
+	// +kubebuilder:validation:Optional
+	Identifier *ConnectionOptionsAttributeIdentifier `json:"identifier,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ProfileRequired *bool `json:"profile_required,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Signup *ConnectionOptionsEmailSignup `json:"signup,omitempty"`
+}
+
+// ConnectionOptionsEmailSignup contains email signup configuration
+type ConnectionOptionsEmailSignup struct {
+	// +kubebuilder:validation:Optional
+	Status *ConnectionOptionsAttributeStatus `json:"status,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Verification *ConnectionOptionsVerification `json:"verification,omitempty"`
+}
+
+// ConnectionOptionsPhoneNumberAttribute contains phone number attribute configuration
+type ConnectionOptionsPhoneNumberAttribute struct {
+	// +kubebuilder:validation:Optional
+	Signup *ConnectionOptionsPhoneNumberSignup `json:"signup,omitempty"`
+}
+
+// ConnectionOptionsPhoneNumberSignup contains phone number signup configuration
+type ConnectionOptionsPhoneNumberSignup struct {
+	// +kubebuilder:validation:Optional
+	Status *ConnectionOptionsAttributeStatus `json:"status,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Verification *ConnectionOptionsVerification `json:"verification,omitempty"`
+}
+
+// ConnectionOptionsUsernameAttribute contains username attribute configuration
+type ConnectionOptionsUsernameAttribute struct {
+	// +kubebuilder:validation:Optional
+	Identifier *ConnectionOptionsAttributeIdentifier `json:"identifier,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ProfileRequired *bool `json:"profile_required,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Signup *ConnectionOptionsUsernameSignup `json:"signup,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Validation *ConnectionOptionsAttributeValidation `json:"validation,omitempty"`
+}
+
+// ConnectionOptionsUsernameSignup contains username signup configuration
+type ConnectionOptionsUsernameSignup struct {
+	// +kubebuilder:validation:Optional
+	Status *ConnectionOptionsAttributeStatus `json:"status,omitempty"`
+}
+
+// ConnectionOptionsAttributeValidation contains attribute validation rules
+type ConnectionOptionsAttributeValidation struct {
+	// +kubebuilder:validation:Optional
+	MinLength *int32 `json:"min_length,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	MaxLength *int32 `json:"max_length,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	AllowedTypes *ConnectionOptionsAttributeAllowedTypes `json:"allowed_types,omitempty"`
+}
+
+// ConnectionOptionsAttributeAllowedTypes lists the identifier types accepted for an attribute
+type ConnectionOptionsAttributeAllowedTypes struct {
+	// +kubebuilder:validation:Optional
+	Email *bool `json:"email,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PhoneNumber *bool `json:"phone_number,omitempty"`
+}
+
+// ConnectionOptionsAuthenticationMethods contains the enabled authentication methods
+type ConnectionOptionsAuthenticationMethods struct {
+	// +kubebuilder:validation:Optional
+	Password *ConnectionOptionsPasswordAuthenticationMethod `json:"password,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Passkey *ConnectionOptionsPasskeyAuthenticationMethod `json:"passkey,omitempty"`
+}
+
+// ConnectionOptionsPasswordAuthenticationMethod contains password authentication configuration
+type ConnectionOptionsPasswordAuthenticationMethod struct {
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ConnectionOptionsPasskeyAuthenticationMethod contains passkey authentication configuration
+type ConnectionOptionsPasskeyAuthenticationMethod struct {
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ConnectionOptionsPasskeyOptions contains passkey configuration
+type ConnectionOptionsPasskeyOptions struct {
+	// +kubebuilder:validation:Optional
+	ChallengeUi *ChallengeUi `json:"challenge_ui,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ProgressiveEnrollmentEnabled *bool `json:"progressive_enrollment_enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	LocalEnrollmentEnabled *bool `json:"local_enrollment_enabled,omitempty"`
+}
+
+// ConnectionOptionsPasswordComplexityOptions contains password complexity configuration
+type ConnectionOptionsPasswordComplexityOptions struct {
+	// +kubebuilder:validation:Optional
+	MinLength *int32 `json:"min_length,omitempty"`
+}
+
+// ConnectionOptionsPasswordHistory contains password history configuration
+type ConnectionOptionsPasswordHistory struct {
+	// +kubebuilder:validation:Optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Size *int32 `json:"size,omitempty"`
+}
+
+// ConnectionOptionsPasswordNoPersonalInfo contains personal information restriction configuration
+type ConnectionOptionsPasswordNoPersonalInfo struct {
+	// +kubebuilder:validation:Optional
+	Enable *bool `json:"enable,omitempty"`
+}
+
+// ConnectionOptionsPasswordDictionary contains password dictionary configuration
+type ConnectionOptionsPasswordDictionary struct {
+	// +kubebuilder:validation:Optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Dictionary []string `json:"dictionary,omitempty"`
+}
+
+// ConnectionOptionsCustomScripts contains the custom database action scripts
+type ConnectionOptionsCustomScripts struct {
+	// +kubebuilder:validation:Optional
+	Login *string `json:"login,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	GetUser *string `json:"get_user,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Delete *string `json:"delete,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ChangePassword *string `json:"change_password,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Verify *string `json:"verify,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Create *string `json:"create,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ChangeUsername *string `json:"change_username,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ChangeEmail *string `json:"change_email,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ChangePhoneNumber *string `json:"change_phone_number,omitempty"`
+}
+
+// GatewayAuthentication contains gateway authentication configuration
+type GatewayAuthentication struct {
+	// +kubebuilder:validation:Optional
+	Method *string `json:"method,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Subject *string `json:"subject,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Audience *string `json:"audience,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Secret *string `json:"secret,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	SecretBase64Encoded *bool `json:"secret_base64_encoded,omitempty"`
+}
@@ -0,0 +1,384 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Connection strategies with typed options
+const (
+	ConnectionStrategyAuth0        = "auth0"
+	ConnectionStrategyGoogleOAuth2 = "google-oauth2"
+	ConnectionStrategySamlp        = "samlp"
+	ConnectionStrategyOidc         = "oidc"
+	ConnectionStrategyWaad         = "waad"
+	ConnectionStrategyOkta         = "okta"
+)
+
+// ConnectionStrategyOptions is implemented by the typed options of a connection strategy
+// +kubebuilder:object:generate=false
+type ConnectionStrategyOptions interface {
+	// Strategy returns the strategy the options apply to
+	Strategy() string
+
+	// Validate checks the options for missing or invalid values
+	Validate(fldPath *field.Path) field.ErrorList
+}
+
+var (
+	connectionStrategiesMu sync.RWMutex
+	connectionStrategies   = map[string]func() ConnectionStrategyOptions{
+		ConnectionStrategyAuth0:        func() ConnectionStrategyOptions { return &ConnectionOptions{} },
+		ConnectionStrategyGoogleOAuth2: func() ConnectionStrategyOptions { return &ConnectionOptionsGoogleOAuth2{} },
+		ConnectionStrategySamlp:        func() ConnectionStrategyOptions { return &ConnectionOptionsSamlp{} },
+		ConnectionStrategyOidc:         func() ConnectionStrategyOptions { return &ConnectionOptionsOidc{} },
+		ConnectionStrategyWaad:         func() ConnectionStrategyOptions { return &ConnectionOptionsWaad{} },
+		ConnectionStrategyOkta:         func() ConnectionStrategyOptions { return &ConnectionOptionsOkta{} },
+	}
+)
+
+// RegisterConnectionStrategy registers the typed options of a strategy, replacing any existing registration.
+func RegisterConnectionStrategy(strategy string, newOptions func() ConnectionStrategyOptions) {
+	connectionStrategiesMu.Lock()
+	defer connectionStrategiesMu.Unlock()
+	connectionStrategies[strategy] = newOptions
+}
+
+// NewConnectionStrategyOptions returns empty typed options for the given strategy.
+// It reports false if no options type is registered for the strategy.
+func NewConnectionStrategyOptions(strategy string) (ConnectionStrategyOptions, bool) {
+	connectionStrategiesMu.RLock()
+	defer connectionStrategiesMu.RUnlock()
+	newOptions, ok := connectionStrategies[strategy]
+	if !ok {
+		return nil, false
+	}
+	return newOptions(), true
+}
+
+// ConnectionStrategies returns the sorted list of strategies with registered options.
+func ConnectionStrategies() []string {
+	connectionStrategiesMu.RLock()
+	defer connectionStrategiesMu.RUnlock()
+	strategies := make([]string, 0, len(connectionStrategies))
+	for strategy := range connectionStrategies {
+		strategies = append(strategies, strategy)
+	}
+	sort.Strings(strategies)
+	return strategies
+}
+
+// GenericConnectionOptions holds the options of a strategy without registered typed options as plain JSON values
+// +kubebuilder:object:generate=false
+type GenericConnectionOptions struct {
+	// StrategyName is the strategy the options apply to
+	StrategyName string
+
+	// Values are the options keyed by their JSON name
+	Values map[string]interface{}
+}
+
+// Strategy returns StrategyName
+func (o *GenericConnectionOptions) Strategy() string { return o.StrategyName }
+
+// Validate accepts any options, since the rules of the strategy are unknown
+func (o *GenericConnectionOptions) Validate(fldPath *field.Path) field.ErrorList { return nil }
+
+// MarshalJSON encodes Values
+func (o *GenericConnectionOptions) MarshalJSON() ([]byte, error) { return json.Marshal(o.Values) }
+
+// UnmarshalJSON decodes Values
+func (o *GenericConnectionOptions) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &o.Values)
+}
+
+// GetStrategyOptions decodes Options into the typed options registered for Strategy, or into
+// GenericConnectionOptions for strategies without registered options.
+// It returns nil if no options are set.
+func (c *ConnectionConf) GetStrategyOptions() (ConnectionStrategyOptions, error) {
+	if c.Strategy == nil || *c.Strategy == "" {
+		return nil, fmt.Errorf("connection strategy is not set")
+	}
+	opts, ok := NewConnectionStrategyOptions(*c.Strategy)
+	if !ok {
+		opts = &GenericConnectionOptions{StrategyName: *c.Strategy}
+	}
+	found, err := decodeOptions(c.Options, opts)
+	if err != nil || !found {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// SetStrategyOptions encodes opts into Options and sets Strategy if it is empty.
+// Keys of the existing Options not modeled by opts are retained, except for GenericConnectionOptions, which model
+// every key.
+func (c *ConnectionConf) SetStrategyOptions(opts ConnectionStrategyOptions) error {
+	if opts == nil {
+		c.Options = nil
+		return nil
+	}
+	if c.Strategy != nil && *c.Strategy != "" && *c.Strategy != opts.Strategy() {
+		return fmt.Errorf("cannot set %q options on a connection with strategy %q", opts.Strategy(), *c.Strategy)
+	}
+	existing := c.Options
+	if _, generic := opts.(*GenericConnectionOptions); generic {
+		existing = nil
+	}
+	raw, err := encodeOptions(existing, opts)
+	if err != nil {
+		return err
+	}
+	strategy := opts.Strategy()
+	c.Strategy = &strategy
+	c.Options = raw
+	return nil
+}
+
+// ValidateOptions validates Options against the typed options registered for Strategy.
+// Options of strategies without registered options are not validated.
+func (c *ConnectionConf) ValidateOptions(fldPath *field.Path) field.ErrorList {
+	if c.Strategy == nil {
+		return nil
+	}
+	if _, ok := NewConnectionStrategyOptions(*c.Strategy); !ok {
+		return nil
+	}
+	opts, err := c.GetStrategyOptions()
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("options"), "<raw>", err.Error())}
+	}
+	if opts == nil {
+		return nil
+	}
+	return opts.Validate(fldPath.Child("options"))
+}
+
+// Strategy returns ConnectionStrategyAuth0
+func (o *ConnectionOptions) Strategy() string { return ConnectionStrategyAuth0 }
+
+// Validate checks the auth0 connection options for invalid values
+func (o *ConnectionOptions) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if o.PasswordPolicy != nil {
+		errs = append(errs, validateEnum(fldPath.Child("passwordPolicy"), string(*o.PasswordPolicy),
+			string(ConnectionOptionsPasswordPolicyNone), string(ConnectionOptionsPasswordPolicyLow), string(ConnectionOptionsPasswordPolicyFair),
+			string(ConnectionOptionsPasswordPolicyGood), string(ConnectionOptionsPasswordPolicyExcellent))...)
+	}
+
+	seen := map[ConnectionOptionsPrecedence]bool{}
+	for i, p := range o.Precedence {
+		path := fldPath.Child("precedence").Index(i)
+		errs = append(errs, validateEnum(path, string(p),
+			string(ConnectionOptionsPrecedenceEmail), string(ConnectionOptionsPrecedencePhoneNumber), string(ConnectionOptionsPrecedenceUsername))...)
+		if seen[p] {
+			errs = append(errs, field.Duplicate(path, p))
+		}
+		seen[p] = true
+	}
+
+	if o.Validation != nil && o.Validation.UserName != nil {
+		errs = append(errs, validateRange(fldPath.Child("validation", "username"), o.Validation.UserName.Min, o.Validation.UserName.Max, "min", "max")...)
+	}
+
+	if a := o.Attributes; a != nil {
+		path := fldPath.Child("attributes")
+		if a.Email != nil && a.Email.Signup != nil {
+			errs = append(errs, validateAttributeStatus(path.Child("email", "signup", "status"), a.Email.Signup.Status)...)
+		}
+		if a.PhoneNumber != nil && a.PhoneNumber.Signup != nil {
+			errs = append(errs, validateAttributeStatus(path.Child("phone_number", "signup", "status"), a.PhoneNumber.Signup.Status)...)
+		}
+		if a.Username != nil {
+			if a.Username.Signup != nil {
+				errs = append(errs, validateAttributeStatus(path.Child("username", "signup", "status"), a.Username.Signup.Status)...)
+			}
+			if v := a.Username.Validation; v != nil {
+				errs = append(errs, validateRange(path.Child("username", "validation"), v.MinLength, v.MaxLength, "min_length", "max_length")...)
+			}
+		}
+	}
+
+	if o.PasskeyOptions != nil && o.PasskeyOptions.ChallengeUi != nil {
+		errs = append(errs, validateEnum(fldPath.Child("passkey_options", "challenge_ui"), string(*o.PasskeyOptions.ChallengeUi),
+			string(ChallengeUiBoth), string(ChallengeUiAutoFill), string(ChallengeUiButton))...)
+	}
+
+	if o.PasswordComplexityOptions != nil && o.PasswordComplexityOptions.MinLength != nil {
+		if v := *o.PasswordComplexityOptions.MinLength; v < 1 || v > 128 {
+			errs = append(errs, field.Invalid(fldPath.Child("password_complexity_options", "min_length"), v, "must be between 1 and 128"))
+		}
+	}
+
+	if o.PasswordHistory != nil && o.PasswordHistory.Size != nil {
+		if v := *o.PasswordHistory.Size; v < 0 || v > 24 {
+			errs = append(errs, field.Invalid(fldPath.Child("password_history", "size"), v, "must be between 0 and 24"))
+		}
+	}
+
+	errs = append(errs, validateSetUserRootAttributes(fldPath, o.SetUserRootAttributes)...)
+	return errs
+}
+
+// Strategy returns ConnectionStrategyGoogleOAuth2
+func (o *ConnectionOptionsGoogleOAuth2) Strategy() string { return ConnectionStrategyGoogleOAuth2 }
+
+// Validate checks the google-oauth2 connection options for missing or invalid values
+func (o *ConnectionOptionsGoogleOAuth2) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if isSet(o.ClientSecret) && !isSet(o.ClientId) {
+		errs = append(errs, field.Required(fldPath.Child("client_id"), "client_id is required when client_secret is set"))
+	}
+	errs = append(errs, validateSetUserRootAttributes(fldPath, o.SetUserRootAttributes)...)
+	return errs
+}
+
+// Strategy returns ConnectionStrategySamlp
+func (o *ConnectionOptionsSamlp) Strategy() string { return ConnectionStrategySamlp }
+
+// Validate checks the samlp connection options for missing or invalid values
+func (o *ConnectionOptionsSamlp) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	hasMetadata := isSet(o.MetadataUrl) || isSet(o.MetadataXml)
+	if !hasMetadata && !isSet(o.SignInEndpoint) {
+		errs = append(errs, field.Required(fldPath.Child("signInEndpoint"), "signInEndpoint is required unless metadataUrl or metadataXml is set"))
+	}
+	if !hasMetadata && !isSet(o.SigningCert) {
+		errs = append(errs, field.Required(fldPath.Child("signingCert"), "signingCert is required unless metadataUrl or metadataXml is set"))
+	}
+	errs = append(errs, validateOptionalEnum(fldPath.Child("signatureAlgorithm"), o.SignatureAlgorithm, "rsa-sha256", "rsa-sha1")...)
+	errs = append(errs, validateOptionalEnum(fldPath.Child("digestAlgorithm"), o.DigestAlgorithm, "sha256", "sha1")...)
+	errs = append(errs, validateOptionalEnum(fldPath.Child("protocolBinding"), o.ProtocolBinding,
+		"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect")...)
+	if o.IdpInitiated != nil {
+		path := fldPath.Child("idpinitiated")
+		errs = append(errs, validateOptionalEnum(path.Child("client_protocol"), o.IdpInitiated.ClientProtocol, "oidc", "samlp", "wsfed")...)
+		if o.IdpInitiated.Enabled != nil && *o.IdpInitiated.Enabled && !isSet(o.IdpInitiated.ClientId) {
+			errs = append(errs, field.Required(path.Child("client_id"), "client_id is required when IdP-initiated sign-on is enabled"))
+		}
+	}
+	errs = append(errs, validateSetUserRootAttributes(fldPath, o.SetUserRootAttributes)...)
+	return errs
+}
+
+// Strategy returns ConnectionStrategyOidc
+func (o *ConnectionOptionsOidc) Strategy() string { return ConnectionStrategyOidc }
+
+// Validate checks the oidc connection options for missing or invalid values
+func (o *ConnectionOptionsOidc) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if !isSet(o.ClientId) {
+		errs = append(errs, field.Required(fldPath.Child("client_id"), ""))
+	}
+	if !isSet(o.DiscoveryUrl) && !isSet(o.Issuer) {
+		errs = append(errs, field.Required(fldPath.Child("discovery_url"), "discovery_url or issuer is required"))
+	}
+	errs = append(errs, validateOptionalEnum(fldPath.Child("type"), o.Type, "back_channel", "front_channel")...)
+	if o.Type != nil && *o.Type == "back_channel" && !isSet(o.ClientSecret) {
+		errs = append(errs, field.Required(fldPath.Child("client_secret"), "client_secret is required for back_channel connections"))
+	}
+	errs = append(errs, validateOidcSettings(fldPath, o.ConnectionSettings, o.AttributeMap)...)
+	errs = append(errs, validateSetUserRootAttributes(fldPath, o.SetUserRootAttributes)...)
+	return errs
+}
+
+// Strategy returns ConnectionStrategyWaad
+func (o *ConnectionOptionsWaad) Strategy() string { return ConnectionStrategyWaad }
+
+// Validate checks the waad connection options for missing or invalid values
+func (o *ConnectionOptionsWaad) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if !isSet(o.ClientId) {
+		errs = append(errs, field.Required(fldPath.Child("client_id"), ""))
+	}
+	if !isSet(o.TenantDomain) && (o.UseCommonEndpoint == nil || !*o.UseCommonEndpoint) {
+		errs = append(errs, field.Required(fldPath.Child("tenant_domain"), "tenant_domain is required unless useCommonEndpoint is set"))
+	}
+	errs = append(errs, validateOptionalEnum(fldPath.Child("waad_protocol"), o.WaadProtocol, "openid-connect", "ws-federation")...)
+	errs = append(errs, validateOptionalEnum(fldPath.Child("identity_api"), o.IdentityApi, "microsoft-identity-platform-v2.0", "azure-active-directory-v1.0")...)
+	errs = append(errs, validateOptionalEnum(fldPath.Child("userid_attribute"), o.UserIdAttribute, "oid", "sub")...)
+	errs = append(errs, validateOptionalEnum(fldPath.Child("should_trust_email_verified_connection"), o.ShouldTrustEmailVerifiedConnection,
+		"never_set_emails_as_verified", "always_set_emails_as_verified")...)
+	errs = append(errs, validateSetUserRootAttributes(fldPath, o.SetUserRootAttributes)...)
+	return errs
+}
+
+// Strategy returns ConnectionStrategyOkta
+func (o *ConnectionOptionsOkta) Strategy() string { return ConnectionStrategyOkta }
+
+// Validate checks the okta connection options for missing or invalid values
+func (o *ConnectionOptionsOkta) Validate(fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if !isSet(o.ClientId) {
+		errs = append(errs, field.Required(fldPath.Child("client_id"), ""))
+	}
+	if !isSet(o.Domain) {
+		errs = append(errs, field.Required(fldPath.Child("domain"), ""))
+	}
+	errs = append(errs, validateOidcSettings(fldPath, o.ConnectionSettings, o.AttributeMap)...)
+	errs = append(errs, validateSetUserRootAttributes(fldPath, o.SetUserRootAttributes)...)
+	return errs
+}
+
+func validateOidcSettings(fldPath *field.Path, settings *ConnectionOptionsConnectionSettings, attributeMap *ConnectionOptionsAttributeMap) field.ErrorList {
+	var errs field.ErrorList
+	if settings != nil {
+		errs = append(errs, validateOptionalEnum(fldPath.Child("connection_settings", "pkce"), settings.Pkce, "auto", "S256", "plain", "disabled")...)
+	}
+	if attributeMap != nil {
+		errs = append(errs, validateOptionalEnum(fldPath.Child("attribute_map", "mapping_mode"), attributeMap.MappingMode, "use_map", "bind_all", "basic_profile")...)
+	}
+	return errs
+}
+
+func validateSetUserRootAttributes(fldPath *field.Path, value *SetUserRootAttributes) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	return validateEnum(fldPath.Child("set_user_root_attributes"), string(*value),
+		string(SetUserRootAttributesOnEachLogin), string(SetUserRootAttributesOnFirstLogin), string(SetUserRootAttributesNeverOnLogin))
+}
+
+func validateAttributeStatus(fldPath *field.Path, value *ConnectionOptionsAttributeStatus) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	return validateEnum(fldPath, string(*value),
+		string(ConnectionOptionsAttributeStatusRequired), string(ConnectionOptionsAttributeStatusOptional), string(ConnectionOptionsAttributeStatusInactive))
+}
+
+func validateRange(fldPath *field.Path, min, max *int32, minName, maxName string) field.ErrorList {
+	var errs field.ErrorList
+	if min != nil && *min < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child(minName), *min, "must be at least 1"))
+	}
+	if min != nil && max != nil && *min > *max {
+		errs = append(errs, field.Invalid(fldPath.Child(maxName), *max, fmt.Sprintf("must not be less than %s", minName)))
+	}
+	return errs
+}
+
+func validateOptionalEnum(fldPath *field.Path, value *string, allowed ...string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	return validateEnum(fldPath, *value, allowed...)
+}
+
+func validateEnum(fldPath *field.Path, value string, allowed ...string) field.ErrorList {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotSupported(fldPath, value, allowed)}
+}
+
+func isSet(s *string) bool {
+	return s != nil && *s != ""
+}
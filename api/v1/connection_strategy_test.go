@@ -0,0 +1,141 @@
+package v1_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestGetStrategyOptions(t *testing.T) {
+	conf := &v1.ConnectionConf{
+		Strategy: strPtr(v1.ConnectionStrategyOidc),
+		Options:  &runtime.RawExtension{Raw: []byte(`{"client_id":"abc","discovery_url":"https://idp.example.com/.well-known/openid-configuration"}`)},
+	}
+	opts, err := conf.GetStrategyOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	oidc, ok := opts.(*v1.ConnectionOptionsOidc)
+	if !ok {
+		t.Fatalf("got %T, want *v1.ConnectionOptionsOidc", opts)
+	}
+	if oidc.ClientId == nil || *oidc.ClientId != "abc" {
+		t.Errorf("client_id = %v, want abc", oidc.ClientId)
+	}
+}
+
+func TestGetStrategyOptionsUnknownStrategy(t *testing.T) {
+	conf := &v1.ConnectionConf{
+		Strategy: strPtr("github"),
+		Options:  &runtime.RawExtension{Raw: []byte(`{"client_id":"abc","scope":["read:user"]}`)},
+	}
+	opts, err := conf.GetStrategyOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	generic, ok := opts.(*v1.GenericConnectionOptions)
+	if !ok {
+		t.Fatalf("got %T, want *v1.GenericConnectionOptions", opts)
+	}
+	want := map[string]interface{}{"client_id": "abc", "scope": []interface{}{"read:user"}}
+	if generic.Strategy() != "github" || !reflect.DeepEqual(generic.Values, want) {
+		t.Errorf("got %s %v, want github %v", generic.Strategy(), generic.Values, want)
+	}
+	if errs := conf.ValidateOptions(field.NewPath("spec", "conf")); len(errs) != 0 {
+		t.Errorf("expected options of an unknown strategy not to be validated, got %v", errs)
+	}
+
+	// removing a value removes it from the options
+	delete(generic.Values, "scope")
+	if err := conf.SetStrategyOptions(generic); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(conf.Options.Raw); got != `{"client_id":"abc"}` {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestRegisterConnectionStrategyTwice(t *testing.T) {
+	v1.RegisterConnectionStrategy("test-twice", func() v1.ConnectionStrategyOptions { return &v1.ConnectionOptionsOidc{} })
+	v1.RegisterConnectionStrategy("test-twice", func() v1.ConnectionStrategyOptions { return &v1.ConnectionOptionsOkta{} })
+
+	opts, ok := v1.NewConnectionStrategyOptions("test-twice")
+	if !ok {
+		t.Fatal("expected test-twice to be registered")
+	}
+	if _, ok := opts.(*v1.ConnectionOptionsOkta); !ok {
+		t.Errorf("got %T, want the options of the last registration", opts)
+	}
+
+	count := 0
+	for _, s := range v1.ConnectionStrategies() {
+		if s == "test-twice" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("test-twice is listed %d times, want once", count)
+	}
+}
+
+func TestValidateOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		strategy string
+		options  string
+		want     []string
+	}{
+		{
+			name:     "valid",
+			strategy: v1.ConnectionStrategyAuth0,
+			options:  `{"precedence":["email","username"],"passwordPolicy":"good"}`,
+		},
+		{
+			name:     "duplicate precedence",
+			strategy: v1.ConnectionStrategyAuth0,
+			options:  `{"precedence":["email","username","email"]}`,
+			want:     []string{"spec.conf.options.precedence[2]"},
+		},
+		{
+			name:     "unsupported password policy",
+			strategy: v1.ConnectionStrategyAuth0,
+			options:  `{"passwordPolicy":"strong"}`,
+			want:     []string{"spec.conf.options.passwordPolicy"},
+		},
+		{
+			name:     "min above max",
+			strategy: v1.ConnectionStrategyAuth0,
+			options:  `{"validation":{"username":{"min":10,"max":5}}}`,
+			want:     []string{"spec.conf.options.validation.username.max"},
+		},
+		{
+			name:     "oidc without client_id or discovery",
+			strategy: v1.ConnectionStrategyOidc,
+			options:  `{}`,
+			want:     []string{"spec.conf.options.client_id", "spec.conf.options.discovery_url"},
+		},
+		{
+			name:     "undecodable options",
+			strategy: v1.ConnectionStrategyOkta,
+			options:  `{"client_id":1}`,
+			want:     []string{"spec.conf.options"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &v1.ConnectionConf{Strategy: strPtr(tc.strategy), Options: &runtime.RawExtension{Raw: []byte(tc.options)}}
+			var got []string
+			for _, err := range conf.ValidateOptions(field.NewPath("spec", "conf")) {
+				got = append(got, err.Field)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
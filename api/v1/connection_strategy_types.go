@@ -0,0 +1,423 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConnectionOptionsGoogleOAuth2 represents the options of a connection using the google-oauth2 strategy
+type ConnectionOptionsGoogleOAuth2 struct {
+	// ClientId is the OAuth2 client ID issued by Google
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret is the OAuth2 client secret issued by Google
+	// +kubebuilder:validation:Optional
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// AllowedAudiences lists additional audiences accepted in Google ID tokens
+	// +kubebuilder:validation:Optional
+	AllowedAudiences []string `json:"allowed_audiences,omitempty"`
+
+	// Scope lists additional OAuth2 scopes to request
+	// +kubebuilder:validation:Optional
+	Scope []string `json:"scope,omitempty"`
+
+	// Email requests the email scope
+	// +kubebuilder:validation:Optional
+	Email *bool `json:"email,omitempty"`
+
+	// Profile requests the profile scope
+	// +kubebuilder:validation:Optional
+	Profile *bool `json:"profile,omitempty"`
+
+	// NonPersistentAttributes lists user attributes that are not persisted
+	// +kubebuilder:validation:Optional
+	NonPersistentAttributes []string `json:"non_persistent_attrs,omitempty"`
+
+	// SetUserRootAttributes specifies when root user attributes are updated
+	// +kubebuilder:validation:Optional
+	SetUserRootAttributes *SetUserRootAttributes `json:"set_user_root_attributes,omitempty"`
+
+	// UpstreamParams contains parameters passed to Google
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UpstreamParams *runtime.RawExtension `json:"upstream_params,omitempty"`
+}
+
+// ConnectionOptionsSamlp represents the options of a connection using the samlp strategy
+type ConnectionOptionsSamlp struct {
+	// SignInEndpoint is the SAML single sign-on URL of the identity provider
+	// +kubebuilder:validation:Optional
+	SignInEndpoint *string `json:"signInEndpoint,omitempty"`
+
+	// SignOutEndpoint is the SAML single logout URL of the identity provider
+	// +kubebuilder:validation:Optional
+	SignOutEndpoint *string `json:"signOutEndpoint,omitempty"`
+
+	// DisableSignout disables sending SAML logout requests
+	// +kubebuilder:validation:Optional
+	DisableSignout *bool `json:"disableSignout,omitempty"`
+
+	// SigningCert is the base64 encoded X.509 signing certificate of the identity provider
+	// +kubebuilder:validation:Optional
+	SigningCert *string `json:"signingCert,omitempty"`
+
+	// SignSAMLRequest indicates whether SAML requests are signed
+	// +kubebuilder:validation:Optional
+	SignSAMLRequest *bool `json:"signSAMLRequest,omitempty"`
+
+	// SignatureAlgorithm is the algorithm used to sign SAML requests
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=rsa-sha256;rsa-sha1
+	SignatureAlgorithm *string `json:"signatureAlgorithm,omitempty"`
+
+	// DigestAlgorithm is the digest algorithm used to sign SAML requests
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=sha256;sha1
+	DigestAlgorithm *string `json:"digestAlgorithm,omitempty"`
+
+	// ProtocolBinding is the SAML binding used for authentication requests
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST";"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	ProtocolBinding *string `json:"protocolBinding,omitempty"`
+
+	// UserIdAttribute is the SAML attribute used as the user ID
+	// +kubebuilder:validation:Optional
+	UserIdAttribute *string `json:"user_id_attribute,omitempty"`
+
+	// Debug enables verbose SAML logging
+	// +kubebuilder:validation:Optional
+	Debug *bool `json:"debug,omitempty"`
+
+	// MetadataUrl is the URL of the identity provider metadata document
+	// +kubebuilder:validation:Optional
+	MetadataUrl *string `json:"metadataUrl,omitempty"`
+
+	// MetadataXml is the identity provider metadata document
+	// +kubebuilder:validation:Optional
+	MetadataXml *string `json:"metadataXml,omitempty"`
+
+	// EntityId is the SAML entity ID of the service provider
+	// +kubebuilder:validation:Optional
+	EntityId *string `json:"entityId,omitempty"`
+
+	// TenantDomain is the primary home realm domain
+	// +kubebuilder:validation:Optional
+	TenantDomain *string `json:"tenant_domain,omitempty"`
+
+	// DomainAliases lists additional home realm domains
+	// +kubebuilder:validation:Optional
+	DomainAliases []string `json:"domain_aliases,omitempty"`
+
+	// IconUrl is the URL of the icon shown on the login button
+	// +kubebuilder:validation:Optional
+	IconUrl *string `json:"icon_url,omitempty"`
+
+	// FieldsMap maps SAML attributes to user profile attributes
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	FieldsMap *runtime.RawExtension `json:"fieldsMap,omitempty"`
+
+	// IdpInitiated contains IdP-initiated sign-on configuration
+	// +kubebuilder:validation:Optional
+	IdpInitiated *ConnectionOptionsIdpInitiated `json:"idpinitiated,omitempty"`
+
+	// NonPersistentAttributes lists user attributes that are not persisted
+	// +kubebuilder:validation:Optional
+	NonPersistentAttributes []string `json:"non_persistent_attrs,omitempty"`
+
+	// SetUserRootAttributes specifies when root user attributes are updated
+	// +kubebuilder:validation:Optional
+	SetUserRootAttributes *SetUserRootAttributes `json:"set_user_root_attributes,omitempty"`
+
+	// UpstreamParams contains parameters passed to the identity provider
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UpstreamParams *runtime.RawExtension `json:"upstream_params,omitempty"`
+}
+
+// ConnectionOptionsIdpInitiated contains IdP-initiated sign-on configuration
+type ConnectionOptionsIdpInitiated struct {
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"client_id,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=oidc;samlp;wsfed
+	ClientProtocol *string `json:"client_protocol,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ClientAuthorizeQuery *string `json:"client_authorizequery,omitempty"`
+}
+
+// ConnectionOptionsOidc represents the options of a connection using the oidc strategy
+type ConnectionOptionsOidc struct {
+	// Type is the OIDC flow used by the connection
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=back_channel;front_channel
+	Type *string `json:"type,omitempty"`
+
+	// ClientId is the client ID issued by the identity provider
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret is the client secret issued by the identity provider
+	// +kubebuilder:validation:Optional
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// DiscoveryUrl is the URL of the OpenID Connect discovery document
+	// +kubebuilder:validation:Optional
+	DiscoveryUrl *string `json:"discovery_url,omitempty"`
+
+	// Issuer is the issuer of the identity provider
+	// +kubebuilder:validation:Optional
+	Issuer *string `json:"issuer,omitempty"`
+
+	// AuthorizationEndpoint is the authorization endpoint of the identity provider
+	// +kubebuilder:validation:Optional
+	AuthorizationEndpoint *string `json:"authorization_endpoint,omitempty"`
+
+	// TokenEndpoint is the token endpoint of the identity provider
+	// +kubebuilder:validation:Optional
+	TokenEndpoint *string `json:"token_endpoint,omitempty"`
+
+	// UserinfoEndpoint is the userinfo endpoint of the identity provider
+	// +kubebuilder:validation:Optional
+	UserinfoEndpoint *string `json:"userinfo_endpoint,omitempty"`
+
+	// JwksUri is the JSON Web Key Set URI of the identity provider
+	// +kubebuilder:validation:Optional
+	JwksUri *string `json:"jwks_uri,omitempty"`
+
+	// Scope is the space separated list of scopes to request
+	// +kubebuilder:validation:Optional
+	Scope *string `json:"scope,omitempty"`
+
+	// TenantDomain is the primary home realm domain
+	// +kubebuilder:validation:Optional
+	TenantDomain *string `json:"tenant_domain,omitempty"`
+
+	// DomainAliases lists additional home realm domains
+	// +kubebuilder:validation:Optional
+	DomainAliases []string `json:"domain_aliases,omitempty"`
+
+	// IconUrl is the URL of the icon shown on the login button
+	// +kubebuilder:validation:Optional
+	IconUrl *string `json:"icon_url,omitempty"`
+
+	// ConnectionSettings contains protocol settings
+	// +kubebuilder:validation:Optional
+	ConnectionSettings *ConnectionOptionsConnectionSettings `json:"connection_settings,omitempty"`
+
+	// AttributeMap maps identity provider claims to user profile attributes
+	// +kubebuilder:validation:Optional
+	AttributeMap *ConnectionOptionsAttributeMap `json:"attribute_map,omitempty"`
+
+	// NonPersistentAttributes lists user attributes that are not persisted
+	// +kubebuilder:validation:Optional
+	NonPersistentAttributes []string `json:"non_persistent_attrs,omitempty"`
+
+	// SetUserRootAttributes specifies when root user attributes are updated
+	// +kubebuilder:validation:Optional
+	SetUserRootAttributes *SetUserRootAttributes `json:"set_user_root_attributes,omitempty"`
+
+	// UpstreamParams contains parameters passed to the identity provider
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UpstreamParams *runtime.RawExtension `json:"upstream_params,omitempty"`
+}
+
+// ConnectionOptionsConnectionSettings contains protocol settings of OIDC based connections
+type ConnectionOptionsConnectionSettings struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=auto;S256;plain;disabled
+	Pkce *string `json:"pkce,omitempty"`
+}
+
+// ConnectionOptionsAttributeMap maps identity provider claims to user profile attributes
+type ConnectionOptionsAttributeMap struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=use_map;bind_all;basic_profile
+	MappingMode *string `json:"mapping_mode,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	UserinfoScope *string `json:"userinfo_scope,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Attributes *runtime.RawExtension `json:"attributes,omitempty"`
+}
+
+// ConnectionOptionsWaad represents the options of a connection using the waad (Microsoft Entra ID) strategy
+type ConnectionOptionsWaad struct {
+	// ClientId is the application ID registered in Microsoft Entra ID
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret is the application secret registered in Microsoft Entra ID
+	// +kubebuilder:validation:Optional
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// TenantDomain is the Microsoft Entra ID tenant domain
+	// +kubebuilder:validation:Optional
+	TenantDomain *string `json:"tenant_domain,omitempty"`
+
+	// DomainAliases lists additional home realm domains
+	// +kubebuilder:validation:Optional
+	DomainAliases []string `json:"domain_aliases,omitempty"`
+
+	// WaadProtocol is the protocol used to communicate with Microsoft Entra ID
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=openid-connect;ws-federation
+	WaadProtocol *string `json:"waad_protocol,omitempty"`
+
+	// IdentityApi is the Microsoft identity API used to retrieve user profiles
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=microsoft-identity-platform-v2.0;azure-active-directory-v1.0
+	IdentityApi *string `json:"identity_api,omitempty"`
+
+	// UseCommonEndpoint enables the common endpoint for multi-tenant applications
+	// +kubebuilder:validation:Optional
+	UseCommonEndpoint *bool `json:"useCommonEndpoint,omitempty"`
+
+	// AppDomain is the Auth0 domain used to register the application
+	// +kubebuilder:validation:Optional
+	AppDomain *string `json:"app_domain,omitempty"`
+
+	// UserIdAttribute is the claim used as the user ID
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=oid;sub
+	UserIdAttribute *string `json:"userid_attribute,omitempty"`
+
+	// MaxGroupsToRetrieve is the maximum number of groups to retrieve
+	// +kubebuilder:validation:Optional
+	MaxGroupsToRetrieve *string `json:"max_groups_to_retrieve,omitempty"`
+
+	// ShouldTrustEmailVerifiedConnection treats emails from this connection as verified
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=never_set_emails_as_verified;always_set_emails_as_verified
+	ShouldTrustEmailVerifiedConnection *string `json:"should_trust_email_verified_connection,omitempty"`
+
+	// ApiEnableUsers enables the users API
+	// +kubebuilder:validation:Optional
+	ApiEnableUsers *bool `json:"api_enable_users,omitempty"`
+
+	// BasicProfile requests the basic profile
+	// +kubebuilder:validation:Optional
+	BasicProfile *bool `json:"basic_profile,omitempty"`
+
+	// ExtProfile requests the extended profile
+	// +kubebuilder:validation:Optional
+	ExtProfile *bool `json:"ext_profile,omitempty"`
+
+	// ExtGroups requests the groups the user belongs to
+	// +kubebuilder:validation:Optional
+	ExtGroups *bool `json:"ext_groups,omitempty"`
+
+	// ExtNestedGroups requests nested groups the user belongs to
+	// +kubebuilder:validation:Optional
+	ExtNestedGroups *bool `json:"ext_nested_groups,omitempty"`
+
+	// ExtAdmin requests the admin extended attribute
+	// +kubebuilder:validation:Optional
+	ExtAdmin *bool `json:"ext_admin,omitempty"`
+
+	// ExtIsSuspended requests the is_suspended extended attribute
+	// +kubebuilder:validation:Optional
+	ExtIsSuspended *bool `json:"ext_is_suspended,omitempty"`
+
+	// ExtAgreedTerms requests the agreed_terms extended attribute
+	// +kubebuilder:validation:Optional
+	ExtAgreedTerms *bool `json:"ext_agreed_terms,omitempty"`
+
+	// ExtAssignedPlans requests the assigned_plans extended attribute
+	// +kubebuilder:validation:Optional
+	ExtAssignedPlans *bool `json:"ext_assigned_plans,omitempty"`
+
+	// IconUrl is the URL of the icon shown on the login button
+	// +kubebuilder:validation:Optional
+	IconUrl *string `json:"icon_url,omitempty"`
+
+	// NonPersistentAttributes lists user attributes that are not persisted
+	// +kubebuilder:validation:Optional
+	NonPersistentAttributes []string `json:"non_persistent_attrs,omitempty"`
+
+	// SetUserRootAttributes specifies when root user attributes are updated
+	// +kubebuilder:validation:Optional
+	SetUserRootAttributes *SetUserRootAttributes `json:"set_user_root_attributes,omitempty"`
+
+	// UpstreamParams contains parameters passed to the identity provider
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UpstreamParams *runtime.RawExtension `json:"upstream_params,omitempty"`
+}
+
+// ConnectionOptionsOkta represents the options of a connection using the okta strategy
+type ConnectionOptionsOkta struct {
+	// ClientId is the client ID issued by Okta
+	// +kubebuilder:validation:Optional
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret is the client secret issued by Okta
+	// +kubebuilder:validation:Optional
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// Domain is the Okta organization domain
+	// +kubebuilder:validation:Optional
+	Domain *string `json:"domain,omitempty"`
+
+	// DomainAliases lists additional home realm domains
+	// +kubebuilder:validation:Optional
+	DomainAliases []string `json:"domain_aliases,omitempty"`
+
+	// Issuer is the issuer of the Okta authorization server
+	// +kubebuilder:validation:Optional
+	Issuer *string `json:"issuer,omitempty"`
+
+	// AuthorizationEndpoint is the authorization endpoint of the Okta authorization server
+	// +kubebuilder:validation:Optional
+	AuthorizationEndpoint *string `json:"authorization_endpoint,omitempty"`
+
+	// TokenEndpoint is the token endpoint of the Okta authorization server
+	// +kubebuilder:validation:Optional
+	TokenEndpoint *string `json:"token_endpoint,omitempty"`
+
+	// UserinfoEndpoint is the userinfo endpoint of the Okta authorization server
+	// +kubebuilder:validation:Optional
+	UserinfoEndpoint *string `json:"userinfo_endpoint,omitempty"`
+
+	// JwksUri is the JSON Web Key Set URI of the Okta authorization server
+	// +kubebuilder:validation:Optional
+	JwksUri *string `json:"jwks_uri,omitempty"`
+
+	// Scope is the space separated list of scopes to request
+	// +kubebuilder:validation:Optional
+	Scope *string `json:"scope,omitempty"`
+
+	// IconUrl is the URL of the icon shown on the login button
+	// +kubebuilder:validation:Optional
+	IconUrl *string `json:"icon_url,omitempty"`
+
+	// ConnectionSettings contains protocol settings
+	// +kubebuilder:validation:Optional
+	ConnectionSettings *ConnectionOptionsConnectionSettings `json:"connection_settings,omitempty"`
+
+	// AttributeMap maps Okta claims to user profile attributes
+	// +kubebuilder:validation:Optional
+	AttributeMap *ConnectionOptionsAttributeMap `json:"attribute_map,omitempty"`
+
+	// NonPersistentAttributes lists user attributes that are not persisted
+	// +kubebuilder:validation:Optional
+	NonPersistentAttributes []string `json:"non_persistent_attrs,omitempty"`
+
+	// SetUserRootAttributes specifies when root user attributes are updated
+	// +kubebuilder:validation:Optional
+	SetUserRootAttributes *SetUserRootAttributes `json:"set_user_root_attributes,omitempty"`
+
+	// UpstreamParams contains parameters passed to Okta
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	UpstreamParams *runtime.RawExtension `json:"upstream_params,omitempty"`
+}
@@ -30,7 +30,24 @@ type A0ConnectionList struct {
 type A0ConnectionSpec struct {
 	// Policy defines the allowed operations for this connection
 	// +kubebuilder:validation:Optional
-	Policy []V1EntityPolicyType `json:"policy,omitempty"`
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:rule="self.all(p, self.exists_one(q, q == p))",message="policy entries must be unique"
+	Policy []V1EntityPolicyType `json:"policy"`
+
+	// Mode defines whether the operator applies the configuration of this connection, only plans the changes or only
+	// observes the connection. Defaults to Apply.
+	// +kubebuilder:validation:Optional
+	Mode V1ReconcileMode `json:"mode,omitempty"`
+
+	// DriftPolicy defines how the operator handles changes made to the connection outside of the operator, e.g. in the
+	// Auth0 dashboard. Defaults to Correct.
+	// +kubebuilder:validation:Optional
+	DriftPolicy V1DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// IgnoreFields are JSON paths into Conf, e.g. "logo_uri" or "jwt_configuration.lifetime_in_seconds", of the
+	// fields the operator neither compares with Auth0 nor updates
+	// +kubebuilder:validation:Optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 
 	// TenantRef is a reference to the A0Tenant this connection belongs to
 	// +kubebuilder:validation:Required
@@ -59,6 +76,32 @@ type A0ConnectionStatus struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	LastConf *runtime.RawExtension `json:"lastConf,omitempty"`
+
+	// DriftBaseline contains LastConf with the last applied values of the fields that drifted in Auth0 and that the
+	// drift policy leaves as they are. It is unset when no field has drifted
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	DriftBaseline *runtime.RawExtension `json:"driftBaseline,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the last time the connection was synchronized with Auth0
+	// +kubebuilder:validation:Optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Plan contains the changes the operator would make to the connection in Plan mode
+	// +kubebuilder:validation:Optional
+	Plan *V1Plan `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the connection
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // ConnectionFind specifies how to find an existing connection in Auth0
@@ -0,0 +1,91 @@
+package v1
+
+// DefaultPolicy is the policy applied to entities that do not specify one
+var DefaultPolicy = []V1EntityPolicyType{PolicyTypeCreate, PolicyTypeUpdate}
+
+// Default sets the default values of the client, writing explicit namespaces into its references
+func (in *A0Client) Default() {
+	in.Spec.Policy = defaultPolicy(in.Spec.Policy)
+	defaultTenantRef(in.Spec.TenantRef, in.Namespace)
+	defaultClientConf(in.Spec.Init, in.Namespace)
+	defaultClientConf(in.Spec.Conf, in.Namespace)
+}
+
+// Default sets the default values of the connection, writing explicit namespaces into its references
+func (in *A0Connection) Default() {
+	in.Spec.Policy = defaultPolicy(in.Spec.Policy)
+	defaultTenantRef(in.Spec.TenantRef, in.Namespace)
+	defaultConnectionConf(in.Spec.Init)
+	defaultConnectionConf(in.Spec.Conf)
+}
+
+// Default sets the default values of the client grant, writing explicit namespaces into its references
+func (in *A0ClientGrant) Default() {
+	in.Spec.Policy = defaultPolicy(in.Spec.Policy)
+	defaultTenantRef(in.Spec.TenantRef, in.Namespace)
+	defaultClientGrantConf(in.Spec.Init, in.Namespace)
+	defaultClientGrantConf(in.Spec.Conf, in.Namespace)
+}
+
+// Default sets the default values of the resource server, writing explicit namespaces into its references
+func (in *A0ResourceServer) Default() {
+	in.Spec.Policy = defaultPolicy(in.Spec.Policy)
+	defaultTenantRef(in.Spec.TenantRef, in.Namespace)
+}
+
+// Default sets the default values of the tenant
+func (in *A0Tenant) Default() {
+	in.Spec.Policy = defaultPolicy(in.Spec.Policy)
+}
+
+// defaultPolicy returns a copy of DefaultPolicy if policy is unset.
+// An explicitly empty policy is kept, as it disables all operations.
+func defaultPolicy(policy []V1EntityPolicyType) []V1EntityPolicyType {
+	if policy != nil {
+		return policy
+	}
+	return append([]V1EntityPolicyType(nil), DefaultPolicy...)
+}
+
+func defaultTenantRef(ref *V1TenantReference, namespace string) {
+	if ref != nil {
+		ref.Namespace = defaultNamespace(ref.Namespace, namespace)
+	}
+}
+
+func defaultClientConf(conf *ClientConf, namespace string) {
+	if conf == nil {
+		return
+	}
+	for i := range conf.EnabledConnections {
+		ref := &conf.EnabledConnections[i]
+		// references by Auth0 ID do not resolve through a namespace
+		if ref.Name != nil {
+			ref.Namespace = defaultNamespace(ref.Namespace, namespace)
+		}
+	}
+}
+
+func defaultConnectionConf(conf *ConnectionConf) {
+	if conf != nil && conf.IsDomainConnection == nil {
+		isDomainConnection := false
+		conf.IsDomainConnection = &isDomainConnection
+	}
+}
+
+func defaultClientGrantConf(conf *ClientGrantConf, namespace string) {
+	if conf != nil && conf.ClientRef != nil && conf.ClientRef.Name != nil {
+		conf.ClientRef.Namespace = defaultNamespace(conf.ClientRef.Namespace, namespace)
+	}
+	if conf != nil && conf.Audience != nil && conf.Audience.Name != nil {
+		conf.Audience.Namespace = defaultNamespace(conf.Audience.Namespace, namespace)
+	}
+}
+
+// defaultNamespace returns namespace if ns is unset or empty.
+func defaultNamespace(ns *string, namespace string) *string {
+	if (ns != nil && *ns != "") || namespace == "" {
+		return ns
+	}
+	return &namespace
+}
@@ -0,0 +1,120 @@
+package v1_test
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestA0ClientDefault(t *testing.T) {
+	obj := &v1.A0Client{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"},
+		Spec: v1.A0ClientSpec{
+			TenantRef: &v1.V1TenantReference{Name: "tenant"},
+			Conf: &v1.ClientConf{EnabledConnections: []v1.V1ConnectionReference{
+				{Name: strPtr("db")},
+				{Name: strPtr("google"), Namespace: strPtr("identity")},
+				{Id: strPtr("con_1")},
+			}},
+		},
+	}
+	obj.Default()
+
+	if !reflect.DeepEqual(obj.Spec.Policy, v1.DefaultPolicy) {
+		t.Errorf("policy = %v, want %v", obj.Spec.Policy, v1.DefaultPolicy)
+	}
+	if ns := obj.Spec.TenantRef.Namespace; ns == nil || *ns != "apps" {
+		t.Errorf("tenantRef.namespace = %v, want apps", ns)
+	}
+	want := []v1.V1ConnectionReference{
+		{Name: strPtr("db"), Namespace: strPtr("apps")},
+		{Name: strPtr("google"), Namespace: strPtr("identity")},
+		{Id: strPtr("con_1")},
+	}
+	if got := obj.Spec.Conf.EnabledConnections; !reflect.DeepEqual(got, want) {
+		t.Errorf("enabled_connections = %v, want %v", got, want)
+	}
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	unset := &v1.A0Tenant{}
+	unset.Default()
+	if !reflect.DeepEqual(unset.Spec.Policy, []v1.V1EntityPolicyType{v1.PolicyTypeCreate, v1.PolicyTypeUpdate}) {
+		t.Errorf("policy = %v, want [Create Update]", unset.Spec.Policy)
+	}
+
+	// the default is a copy, so changing it does not change DefaultPolicy
+	unset.Spec.Policy[0] = v1.PolicyTypeDelete
+	if v1.DefaultPolicy[0] != v1.PolicyTypeCreate {
+		t.Errorf("DefaultPolicy was changed through a defaulted resource: %v", v1.DefaultPolicy)
+	}
+
+	empty := &v1.A0ResourceServer{Spec: v1.A0ResourceServerSpec{Policy: []v1.V1EntityPolicyType{}}}
+	empty.Default()
+	if empty.Spec.Policy == nil || len(empty.Spec.Policy) != 0 {
+		t.Errorf("policy = %#v, want the explicit empty policy to be kept", empty.Spec.Policy)
+	}
+}
+
+func TestA0ClientGrantDefault(t *testing.T) {
+	obj := &v1.A0ClientGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-api", Namespace: "apps"},
+		Spec: v1.A0ClientGrantSpec{
+			TenantRef: &v1.V1TenantReference{Name: "tenant", Namespace: strPtr("auth0")},
+			Init: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Id: strPtr("abc")},
+				Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("https://api.example.com")},
+			},
+			Conf: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Name: strPtr("web")},
+				Audience:  &v1.V1ResourceServerReference{Name: strPtr("api")},
+			},
+		},
+	}
+	obj.Default()
+
+	if ns := obj.Spec.TenantRef.Namespace; *ns != "auth0" {
+		t.Errorf("tenantRef.namespace = %s, want the explicit namespace to be kept", *ns)
+	}
+	if ns := obj.Spec.Conf.ClientRef.Namespace; ns == nil || *ns != "apps" {
+		t.Errorf("conf.clientRef.namespace = %v, want apps", ns)
+	}
+	if ns := obj.Spec.Conf.Audience.Namespace; ns == nil || *ns != "apps" {
+		t.Errorf("conf.audience.namespace = %v, want apps", ns)
+	}
+	if obj.Spec.Init.ClientRef.Namespace != nil || obj.Spec.Init.Audience.Namespace != nil {
+		t.Errorf("expected references by ID or identifier to stay without a namespace, got %+v %+v", obj.Spec.Init.ClientRef, obj.Spec.Init.Audience)
+	}
+}
+
+func TestA0ConnectionDefault(t *testing.T) {
+	obj := &v1.A0Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "apps"},
+		Spec: v1.A0ConnectionSpec{
+			TenantRef: &v1.V1TenantReference{Name: "tenant"},
+			Init:      &v1.ConnectionConf{IsDomainConnection: boolPtr(true)},
+			Conf:      &v1.ConnectionConf{},
+		},
+	}
+	obj.Default()
+
+	if v := obj.Spec.Conf.IsDomainConnection; v == nil || *v {
+		t.Errorf("conf.is_domain_connection = %v, want false", v)
+	}
+	if v := obj.Spec.Init.IsDomainConnection; !*v {
+		t.Error("expected an explicit is_domain_connection to be kept")
+	}
+}
+
+func TestDefaultWithoutNamespace(t *testing.T) {
+	obj := &v1.A0Client{Spec: v1.A0ClientSpec{TenantRef: &v1.V1TenantReference{Name: "tenant"}}}
+	obj.Default()
+	if obj.Spec.TenantRef.Namespace != nil {
+		t.Errorf("tenantRef.namespace = %q, want it to stay unset for an object without a namespace", *obj.Spec.TenantRef.Namespace)
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
@@ -10,4 +10,7 @@ import (
 var (
 	// GroupVersion is group version used to register these objects
 	GroupVersion = schema.GroupVersion{Group: "kubernetes.auth0.com", Version: "v1"}
+
+	// SchemeGroupVersion is an alias of GroupVersion expected by the generated clientset in pkg/generated
+	SchemeGroupVersion = GroupVersion
 )
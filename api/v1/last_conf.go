@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LastAppliedConf decodes Status.LastConf, the client as last returned by Auth0, or returns nil when it is unset.
+// The connection IDs of enabled_connections become references by id.
+func (in *A0Client) LastAppliedConf() (*ClientConf, error) {
+	obj, err := lastConfObject(in.Status.LastConf)
+	if obj == nil || err != nil {
+		return nil, err
+	}
+	if ids, ok := obj["enabled_connections"].([]interface{}); ok {
+		refs := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			if s, ok := id.(string); ok {
+				refs = append(refs, map[string]interface{}{"id": s})
+			} else {
+				refs = append(refs, id)
+			}
+		}
+		obj["enabled_connections"] = refs
+	}
+	conf := &ClientConf{}
+	return conf, decodeLastConf(obj, conf)
+}
+
+// LastAppliedConf decodes Status.LastConf, the connection as last returned by Auth0, or returns nil when it is unset.
+func (in *A0Connection) LastAppliedConf() (*ConnectionConf, error) {
+	obj, err := lastConfObject(in.Status.LastConf)
+	if obj == nil || err != nil {
+		return nil, err
+	}
+	conf := &ConnectionConf{}
+	return conf, decodeLastConf(obj, conf)
+}
+
+// LastAppliedConf decodes Status.LastConf, the resource server as last returned by Auth0, or returns nil when it is
+// unset.
+func (in *A0ResourceServer) LastAppliedConf() (*ResourceServerConf, error) {
+	obj, err := lastConfObject(in.Status.LastConf)
+	if obj == nil || err != nil {
+		return nil, err
+	}
+	conf := &ResourceServerConf{}
+	return conf, decodeLastConf(obj, conf)
+}
+
+// LastAppliedConf decodes Status.LastConf, the grant as last returned by Auth0, or returns nil when it is unset.
+// client_id and audience become a clientRef by id and an audience by identifier.
+func (in *A0ClientGrant) LastAppliedConf() (*ClientGrantConf, error) {
+	obj, err := lastConfObject(in.Status.LastConf)
+	if obj == nil || err != nil {
+		return nil, err
+	}
+	conf := &ClientGrantConf{}
+	if id, ok := obj["client_id"].(string); ok {
+		conf.ClientRef = &V1ClientReference{Id: &id}
+	}
+	if identifier, ok := obj["audience"].(string); ok {
+		conf.Audience = &V1ResourceServerReference{Identifier: &identifier}
+	}
+	delete(obj, "client_id")
+	delete(obj, "audience")
+	return conf, decodeLastConf(obj, conf)
+}
+
+// LastAppliedConf decodes Status.LastConf, the tenant settings as last returned by Auth0, or returns nil when they
+// are unset.
+func (in *A0Tenant) LastAppliedConf() (*TenantConf, error) {
+	obj, err := lastConfObject(in.Status.LastConf)
+	if obj == nil || err != nil {
+		return nil, err
+	}
+	conf := &TenantConf{}
+	return conf, decodeLastConf(obj, conf)
+}
+
+// lastConfObject returns the JSON object stored in raw, or nil when raw is empty.
+func lastConfObject(raw *runtime.RawExtension) (map[string]interface{}, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(raw.Raw, &obj); err != nil {
+		return nil, fmt.Errorf("invalid lastConf: %w", err)
+	}
+	return obj, nil
+}
+
+func decodeLastConf(obj map[string]interface{}, conf interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, conf); err != nil {
+		return fmt.Errorf("invalid lastConf: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package v1_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestLastAppliedConf(t *testing.T) {
+	client := &v1.A0Client{Status: v1.A0ClientStatus{LastConf: &runtime.RawExtension{Raw: []byte(
+		`{"client_id":"abc","name":"my-app","callbacks":["https://a.example.com/cb"],"enabled_connections":["con_1"],"client_metadata":{"team":"identity"}}`,
+	)}}}
+	conf, err := client.LastAppliedConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *conf.Name != "my-app" || len(conf.Callbacks) != 1 || string(conf.ClientMetadata.Raw) != `{"team":"identity"}` {
+		t.Errorf("unexpected conf %+v", conf)
+	}
+	if len(conf.EnabledConnections) != 1 || *conf.EnabledConnections[0].Id != "con_1" {
+		t.Errorf("expected a reference to con_1, got %+v", conf.EnabledConnections)
+	}
+
+	grant := &v1.A0ClientGrant{Status: v1.A0ClientGrantStatus{LastConf: &runtime.RawExtension{Raw: []byte(
+		`{"id":"cgr_1","client_id":"abc","audience":"https://api.example.com","scope":["read:things"]}`,
+	)}}}
+	grantConf, err := grant.LastAppliedConf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *grantConf.ClientRef.Id != "abc" || *grantConf.Audience.Identifier != "https://api.example.com" || grantConf.Scope[0] != "read:things" {
+		t.Errorf("unexpected conf %+v", grantConf)
+	}
+
+	if conf, err := (&v1.A0ResourceServer{}).LastAppliedConf(); conf != nil || err != nil {
+		t.Errorf("expected nil for an unset lastConf, got %v, %v", conf, err)
+	}
+
+	tenant := &v1.A0Tenant{Status: v1.A0TenantStatus{LastConf: &runtime.RawExtension{Raw: []byte(`{"friendly_name":1}`)}}}
+	if _, err := tenant.LastAppliedConf(); err == nil {
+		t.Error("expected an error for a lastConf that does not match the configuration")
+	}
+}
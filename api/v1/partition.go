@@ -0,0 +1,18 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PartitionAnnotationKey is the annotation that assigns a resource to an operator partition
+const PartitionAnnotationKey = "kubernetes.auth0.com/partition"
+
+// InPartition reports whether obj is processed by an operator running for partition. An operator without
+// a partition only processes resources without the annotation, the same as the C# operator.
+func InPartition(obj metav1.Object, partition string) bool {
+	value, ok := obj.GetAnnotations()[PartitionAnnotationKey]
+	if partition == "" {
+		return !ok
+	}
+	return ok && value == partition
+}
@@ -0,0 +1,35 @@
+package v1_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestInPartition(t *testing.T) {
+	annotated := func(annotations map[string]string) *v1.A0Client {
+		return &v1.A0Client{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+	unannotated := annotated(nil)
+	east := annotated(map[string]string{v1.PartitionAnnotationKey: "east"})
+	empty := annotated(map[string]string{v1.PartitionAnnotationKey: ""})
+
+	for _, tc := range []struct {
+		obj       *v1.A0Client
+		partition string
+		want      bool
+	}{
+		{unannotated, "", true},
+		{east, "", false},
+		{empty, "", false},
+		{unannotated, "east", false},
+		{east, "east", true},
+		{east, "west", false},
+	} {
+		if got := v1.InPartition(tc.obj, tc.partition); got != tc.want {
+			t.Errorf("InPartition(%v, %q) = %v, want %v", tc.obj.Annotations, tc.partition, got, tc.want)
+		}
+	}
+}
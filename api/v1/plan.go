@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GetMode returns the effective reconcile mode of the tenant, ReconcileModeApply when none is set
+func (in *A0Tenant) GetMode() V1ReconcileMode { return effectiveMode(in.Spec.Mode) }
+
+// GetPlan returns the changes recorded in Plan mode, or nil
+func (in *A0Tenant) GetPlan() *V1Plan { return in.Status.Plan }
+
+// SetPlan records the changes the operator would make in Plan mode
+func (in *A0Tenant) SetPlan(plan *V1Plan) { in.Status.Plan = plan }
+
+// Pending reports whether applying the configuration would change the entity, false for a nil plan.
+func (in *V1Plan) Pending() bool {
+	return in != nil && in.Action != PlanActionNone
+}
+
+// String formats the plan for review: the action, followed by a line for each change.
+func (in *V1Plan) String() string {
+	if in == nil {
+		return "<none>"
+	}
+	var b strings.Builder
+	b.WriteString(string(in.Action))
+	if in.Message != "" {
+		b.WriteString(": " + in.Message)
+	}
+	for _, c := range in.Changes {
+		b.WriteString("\n  " + c.String())
+	}
+	return b.String()
+}
+
+// String formats the change the same way as a drift.Difference, e.g. "~ logo_uri: "old" -> "new"".
+func (in V1PlanChange) String() string {
+	switch in.Type {
+	case "Added":
+		return fmt.Sprintf("+ %s: %s", in.Path, in.Desired)
+	case "Removed":
+		return fmt.Sprintf("- %s: %s", in.Path, in.Live)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", in.Path, in.Live, in.Desired)
+	}
+}
@@ -0,0 +1,392 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TenantEntity is implemented by the A0* resources that live in an A0Tenant: A0Client, A0Connection,
+// A0ClientGrant and A0ResourceServer. It gives generic code access to the parts of their spec and
+// status that do not depend on the kind.
+// +kubebuilder:object:generate=false
+type TenantEntity interface {
+	SyncStatusAccessor
+	runtime.Object
+
+	// GetTenantRef returns the reference to the tenant of the entity
+	GetTenantRef() *V1TenantReference
+
+	// GetPolicy returns the effective policy of the entity, DefaultPolicy when none is set
+	GetPolicy() []V1EntityPolicyType
+
+	// GetInit returns the typed initial configuration of the entity, e.g. *ClientConf, or nil when unset
+	GetInit() interface{}
+
+	// GetConf returns the typed desired configuration of the entity, e.g. *ClientConf, or nil when unset
+	GetConf() interface{}
+
+	// GetAuth0ID returns the ID of the entity in Auth0 recorded in its status, or an empty string
+	GetAuth0ID() string
+
+	// SetAuth0ID records the ID of the entity in Auth0 in its status
+	SetAuth0ID(id string)
+
+	// GetLastConf returns the last configuration applied to Auth0
+	GetLastConf() *runtime.RawExtension
+
+	// SetLastConf records the last configuration applied to Auth0
+	SetLastConf(conf *runtime.RawExtension)
+
+	// GetDriftBaseline returns the configuration drifted fields are compared against, or nil when none have drifted
+	GetDriftBaseline() *runtime.RawExtension
+
+	// SetDriftBaseline records the configuration drifted fields are compared against
+	SetDriftBaseline(conf *runtime.RawExtension)
+
+	// GetMode returns the effective reconcile mode of the entity, ReconcileModeApply when none is set
+	GetMode() V1ReconcileMode
+
+	// GetPlan returns the changes recorded in Plan mode, or nil
+	GetPlan() *V1Plan
+
+	// SetPlan records the changes the operator would make in Plan mode
+	SetPlan(plan *V1Plan)
+
+	// GetDriftPolicy returns the effective drift policy of the entity, DriftPolicyCorrect when none is set
+	GetDriftPolicy() V1DriftPolicy
+
+	// GetIgnoreFields returns the JSON paths into Conf of the fields the operator leaves unmanaged
+	GetIgnoreFields() []string
+}
+
+// TenantEntityList is implemented by the lists of the TenantEntity kinds
+// +kubebuilder:object:generate=false
+type TenantEntityList interface {
+	runtime.Object
+
+	// GetTenantEntities returns pointers to the items of the list
+	GetTenantEntities() []TenantEntity
+}
+
+var (
+	_ TenantEntity = &A0Client{}
+	_ TenantEntity = &A0Connection{}
+	_ TenantEntity = &A0ClientGrant{}
+	_ TenantEntity = &A0ResourceServer{}
+
+	_ TenantEntityList = &A0ClientList{}
+	_ TenantEntityList = &A0ConnectionList{}
+	_ TenantEntityList = &A0ClientGrantList{}
+	_ TenantEntityList = &A0ResourceServerList{}
+)
+
+// HasPolicy returns true if the effective policy of entity allows the given operation
+func HasPolicy(entity TenantEntity, policy V1EntityPolicyType) bool {
+	for _, p := range entity.GetPolicy() {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// effectivePolicy returns policy, or DefaultPolicy if policy is unset.
+func effectivePolicy(policy []V1EntityPolicyType) []V1EntityPolicyType {
+	if policy == nil {
+		return DefaultPolicy
+	}
+	return policy
+}
+
+// effectiveMode returns mode, or ReconcileModeApply if mode is unset.
+func effectiveMode(mode V1ReconcileMode) V1ReconcileMode {
+	if mode == "" {
+		return ReconcileModeApply
+	}
+	return mode
+}
+
+// effectiveDriftPolicy returns policy, or DriftPolicyCorrect if policy is unset.
+func effectiveDriftPolicy(policy V1DriftPolicy) V1DriftPolicy {
+	if policy == "" {
+		return DriftPolicyCorrect
+	}
+	return policy
+}
+
+// GetTenantRef implements TenantEntity
+func (in *A0Client) GetTenantRef() *V1TenantReference { return in.Spec.TenantRef }
+
+// GetPolicy implements TenantEntity
+func (in *A0Client) GetPolicy() []V1EntityPolicyType { return effectivePolicy(in.Spec.Policy) }
+
+// GetInit implements TenantEntity
+func (in *A0Client) GetInit() interface{} {
+	if in.Spec.Init == nil {
+		return nil
+	}
+	return in.Spec.Init
+}
+
+// GetConf implements TenantEntity
+func (in *A0Client) GetConf() interface{} {
+	if in.Spec.Conf == nil {
+		return nil
+	}
+	return in.Spec.Conf
+}
+
+// GetAuth0ID implements TenantEntity
+func (in *A0Client) GetAuth0ID() string { return stringValue(in.Status.Id) }
+
+// SetAuth0ID implements TenantEntity
+func (in *A0Client) SetAuth0ID(id string) { in.Status.Id = stringPtrOrNil(id) }
+
+// GetLastConf implements TenantEntity
+func (in *A0Client) GetLastConf() *runtime.RawExtension { return in.Status.LastConf }
+
+// SetLastConf implements TenantEntity
+func (in *A0Client) SetLastConf(conf *runtime.RawExtension) { in.Status.LastConf = conf }
+
+// GetDriftBaseline implements TenantEntity
+func (in *A0Client) GetDriftBaseline() *runtime.RawExtension { return in.Status.DriftBaseline }
+
+// SetDriftBaseline implements TenantEntity
+func (in *A0Client) SetDriftBaseline(conf *runtime.RawExtension) { in.Status.DriftBaseline = conf }
+
+// GetMode implements TenantEntity
+func (in *A0Client) GetMode() V1ReconcileMode { return effectiveMode(in.Spec.Mode) }
+
+// GetPlan implements TenantEntity
+func (in *A0Client) GetPlan() *V1Plan { return in.Status.Plan }
+
+// SetPlan implements TenantEntity
+func (in *A0Client) SetPlan(plan *V1Plan) { in.Status.Plan = plan }
+
+// GetDriftPolicy implements TenantEntity
+func (in *A0Client) GetDriftPolicy() V1DriftPolicy { return effectiveDriftPolicy(in.Spec.DriftPolicy) }
+
+// GetIgnoreFields implements TenantEntity
+func (in *A0Client) GetIgnoreFields() []string { return in.Spec.IgnoreFields }
+
+// GetTenantRef implements TenantEntity
+func (in *A0Connection) GetTenantRef() *V1TenantReference { return in.Spec.TenantRef }
+
+// GetPolicy implements TenantEntity
+func (in *A0Connection) GetPolicy() []V1EntityPolicyType { return effectivePolicy(in.Spec.Policy) }
+
+// GetInit implements TenantEntity
+func (in *A0Connection) GetInit() interface{} {
+	if in.Spec.Init == nil {
+		return nil
+	}
+	return in.Spec.Init
+}
+
+// GetConf implements TenantEntity
+func (in *A0Connection) GetConf() interface{} {
+	if in.Spec.Conf == nil {
+		return nil
+	}
+	return in.Spec.Conf
+}
+
+// GetAuth0ID implements TenantEntity
+func (in *A0Connection) GetAuth0ID() string { return stringValue(in.Status.Id) }
+
+// SetAuth0ID implements TenantEntity
+func (in *A0Connection) SetAuth0ID(id string) { in.Status.Id = stringPtrOrNil(id) }
+
+// GetLastConf implements TenantEntity
+func (in *A0Connection) GetLastConf() *runtime.RawExtension { return in.Status.LastConf }
+
+// SetLastConf implements TenantEntity
+func (in *A0Connection) SetLastConf(conf *runtime.RawExtension) { in.Status.LastConf = conf }
+
+// GetDriftBaseline implements TenantEntity
+func (in *A0Connection) GetDriftBaseline() *runtime.RawExtension { return in.Status.DriftBaseline }
+
+// SetDriftBaseline implements TenantEntity
+func (in *A0Connection) SetDriftBaseline(conf *runtime.RawExtension) { in.Status.DriftBaseline = conf }
+
+// GetMode implements TenantEntity
+func (in *A0Connection) GetMode() V1ReconcileMode { return effectiveMode(in.Spec.Mode) }
+
+// GetPlan implements TenantEntity
+func (in *A0Connection) GetPlan() *V1Plan { return in.Status.Plan }
+
+// SetPlan implements TenantEntity
+func (in *A0Connection) SetPlan(plan *V1Plan) { in.Status.Plan = plan }
+
+// GetDriftPolicy implements TenantEntity
+func (in *A0Connection) GetDriftPolicy() V1DriftPolicy {
+	return effectiveDriftPolicy(in.Spec.DriftPolicy)
+}
+
+// GetIgnoreFields implements TenantEntity
+func (in *A0Connection) GetIgnoreFields() []string { return in.Spec.IgnoreFields }
+
+// GetTenantRef implements TenantEntity
+func (in *A0ClientGrant) GetTenantRef() *V1TenantReference { return in.Spec.TenantRef }
+
+// GetPolicy implements TenantEntity
+func (in *A0ClientGrant) GetPolicy() []V1EntityPolicyType { return effectivePolicy(in.Spec.Policy) }
+
+// GetInit implements TenantEntity
+func (in *A0ClientGrant) GetInit() interface{} {
+	if in.Spec.Init == nil {
+		return nil
+	}
+	return in.Spec.Init
+}
+
+// GetConf implements TenantEntity
+func (in *A0ClientGrant) GetConf() interface{} {
+	if in.Spec.Conf == nil {
+		return nil
+	}
+	return in.Spec.Conf
+}
+
+// GetAuth0ID implements TenantEntity
+func (in *A0ClientGrant) GetAuth0ID() string { return stringValue(in.Status.Id) }
+
+// SetAuth0ID implements TenantEntity
+func (in *A0ClientGrant) SetAuth0ID(id string) { in.Status.Id = stringPtrOrNil(id) }
+
+// GetLastConf implements TenantEntity
+func (in *A0ClientGrant) GetLastConf() *runtime.RawExtension { return in.Status.LastConf }
+
+// SetLastConf implements TenantEntity
+func (in *A0ClientGrant) SetLastConf(conf *runtime.RawExtension) { in.Status.LastConf = conf }
+
+// GetDriftBaseline implements TenantEntity
+func (in *A0ClientGrant) GetDriftBaseline() *runtime.RawExtension { return in.Status.DriftBaseline }
+
+// SetDriftBaseline implements TenantEntity
+func (in *A0ClientGrant) SetDriftBaseline(conf *runtime.RawExtension) { in.Status.DriftBaseline = conf }
+
+// GetMode implements TenantEntity
+func (in *A0ClientGrant) GetMode() V1ReconcileMode { return effectiveMode(in.Spec.Mode) }
+
+// GetPlan implements TenantEntity
+func (in *A0ClientGrant) GetPlan() *V1Plan { return in.Status.Plan }
+
+// SetPlan implements TenantEntity
+func (in *A0ClientGrant) SetPlan(plan *V1Plan) { in.Status.Plan = plan }
+
+// GetDriftPolicy implements TenantEntity
+func (in *A0ClientGrant) GetDriftPolicy() V1DriftPolicy {
+	return effectiveDriftPolicy(in.Spec.DriftPolicy)
+}
+
+// GetIgnoreFields implements TenantEntity
+func (in *A0ClientGrant) GetIgnoreFields() []string { return in.Spec.IgnoreFields }
+
+// GetTenantRef implements TenantEntity
+func (in *A0ResourceServer) GetTenantRef() *V1TenantReference { return in.Spec.TenantRef }
+
+// GetPolicy implements TenantEntity
+func (in *A0ResourceServer) GetPolicy() []V1EntityPolicyType { return effectivePolicy(in.Spec.Policy) }
+
+// GetInit implements TenantEntity
+func (in *A0ResourceServer) GetInit() interface{} {
+	if in.Spec.Init == nil {
+		return nil
+	}
+	return in.Spec.Init
+}
+
+// GetConf implements TenantEntity
+func (in *A0ResourceServer) GetConf() interface{} {
+	if in.Spec.Conf == nil {
+		return nil
+	}
+	return in.Spec.Conf
+}
+
+// GetAuth0ID implements TenantEntity
+func (in *A0ResourceServer) GetAuth0ID() string { return stringValue(in.Status.Id) }
+
+// SetAuth0ID implements TenantEntity
+func (in *A0ResourceServer) SetAuth0ID(id string) { in.Status.Id = stringPtrOrNil(id) }
+
+// GetLastConf implements TenantEntity
+func (in *A0ResourceServer) GetLastConf() *runtime.RawExtension { return in.Status.LastConf }
+
+// SetLastConf implements TenantEntity
+func (in *A0ResourceServer) SetLastConf(conf *runtime.RawExtension) { in.Status.LastConf = conf }
+
+// GetDriftBaseline implements TenantEntity
+func (in *A0ResourceServer) GetDriftBaseline() *runtime.RawExtension { return in.Status.DriftBaseline }
+
+// SetDriftBaseline implements TenantEntity
+func (in *A0ResourceServer) SetDriftBaseline(conf *runtime.RawExtension) {
+	in.Status.DriftBaseline = conf
+}
+
+// GetMode implements TenantEntity
+func (in *A0ResourceServer) GetMode() V1ReconcileMode { return effectiveMode(in.Spec.Mode) }
+
+// GetPlan implements TenantEntity
+func (in *A0ResourceServer) GetPlan() *V1Plan { return in.Status.Plan }
+
+// SetPlan implements TenantEntity
+func (in *A0ResourceServer) SetPlan(plan *V1Plan) { in.Status.Plan = plan }
+
+// GetDriftPolicy implements TenantEntity
+func (in *A0ResourceServer) GetDriftPolicy() V1DriftPolicy {
+	return effectiveDriftPolicy(in.Spec.DriftPolicy)
+}
+
+// GetIgnoreFields implements TenantEntity
+func (in *A0ResourceServer) GetIgnoreFields() []string { return in.Spec.IgnoreFields }
+
+// GetTenantEntities implements TenantEntityList
+func (in *A0ClientList) GetTenantEntities() []TenantEntity {
+	out := make([]TenantEntity, len(in.Items))
+	for i := range in.Items {
+		out[i] = &in.Items[i]
+	}
+	return out
+}
+
+// GetTenantEntities implements TenantEntityList
+func (in *A0ConnectionList) GetTenantEntities() []TenantEntity {
+	out := make([]TenantEntity, len(in.Items))
+	for i := range in.Items {
+		out[i] = &in.Items[i]
+	}
+	return out
+}
+
+// GetTenantEntities implements TenantEntityList
+func (in *A0ClientGrantList) GetTenantEntities() []TenantEntity {
+	out := make([]TenantEntity, len(in.Items))
+	for i := range in.Items {
+		out[i] = &in.Items[i]
+	}
+	return out
+}
+
+// GetTenantEntities implements TenantEntityList
+func (in *A0ResourceServerList) GetTenantEntities() []TenantEntity {
+	out := make([]TenantEntity, len(in.Items))
+	for i := range in.Items {
+		out[i] = &in.Items[i]
+	}
+	return out
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,56 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestTenantEntity(t *testing.T) {
+	list := &v1.A0ResourceServerList{Items: []v1.A0ResourceServer{
+		{Spec: v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: &v1.ResourceServerConf{Identifier: strPtr("https://api.example.com")}}},
+		{Spec: v1.A0ResourceServerSpec{Policy: []v1.V1EntityPolicyType{}}},
+	}}
+
+	entities := list.GetTenantEntities()
+	if len(entities) != 2 {
+		t.Fatalf("expected 2 entities, got %d", len(entities))
+	}
+
+	first := entities[0]
+	if first.GetTenantRef().Name != "tenant" {
+		t.Fatalf("unexpected tenant ref %v", first.GetTenantRef())
+	}
+	if !v1.HasPolicy(first, v1.PolicyTypeCreate) || v1.HasPolicy(first, v1.PolicyTypeDelete) {
+		t.Fatalf("expected the default policy, got %v", first.GetPolicy())
+	}
+	if first.GetInit() != nil {
+		t.Fatalf("expected an unset init to be nil, got %#v", first.GetInit())
+	}
+	if conf, ok := first.GetConf().(*v1.ResourceServerConf); !ok || *conf.Identifier != "https://api.example.com" {
+		t.Fatalf("unexpected conf %#v", first.GetConf())
+	}
+
+	first.SetAuth0ID("rs_123")
+	if list.Items[0].Status.Id == nil || *list.Items[0].Status.Id != "rs_123" {
+		t.Fatalf("expected the ID to be written to the list item, got %v", list.Items[0].Status.Id)
+	}
+
+	if v1.HasPolicy(entities[1], v1.PolicyTypeCreate) {
+		t.Fatalf("expected an explicitly empty policy to deny all operations")
+	}
+
+	// the empty policy is kept on the wire, as it is in every update the operator sends
+	raw, err := json.Marshal(&list.Items[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &v1.A0ResourceServer{}
+	if err := json.Unmarshal(raw, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if v1.HasPolicy(decoded, v1.PolicyTypeCreate) || v1.HasPolicy(decoded, v1.PolicyTypeUpdate) {
+		t.Fatalf("expected an empty policy to deny all operations after a round trip, got %s", raw)
+	}
+}
@@ -30,7 +30,14 @@ type A0TenantList struct {
 type A0TenantSpec struct {
 	// Policy defines the allowed operations for this tenant
 	// +kubebuilder:validation:Optional
-	Policy []V1EntityPolicyType `json:"policy,omitempty"`
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:rule="self.all(p, self.exists_one(q, q == p))",message="policy entries must be unique"
+	Policy []V1EntityPolicyType `json:"policy"`
+
+	// Mode defines whether the operator applies the configuration of this tenant, only plans the changes or only
+	// observes the tenant. Defaults to Apply.
+	// +kubebuilder:validation:Optional
+	Mode V1ReconcileMode `json:"mode,omitempty"`
 
 	// Name is the name of the tenant
 	// +kubebuilder:validation:Required
@@ -55,6 +62,26 @@ type A0TenantStatus struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	LastConf *runtime.RawExtension `json:"lastConf,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the last time the tenant was synchronized with Auth0
+	// +kubebuilder:validation:Optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Plan contains the changes the operator would make to the tenant in Plan mode
+	// +kubebuilder:validation:Optional
+	Plan *V1Plan `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the tenant
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // TenantAuth contains authentication configuration for accessing Auth0 Management API
@@ -69,6 +96,7 @@ type TenantAuth struct {
 }
 
 // TenantConf defines the configuration for an Auth0 tenant
+// +kubebuilder:validation:XValidation:rule="!has(self.idle_session_lifetime) || !has(self.session_lifetime) || self.idle_session_lifetime <= self.session_lifetime",message="idle_session_lifetime must not exceed session_lifetime"
 type TenantConf struct {
 	// FriendlyName is the human-readable name of the tenant
 	// +kubebuilder:validation:Optional
@@ -120,8 +148,7 @@ type TenantConf struct {
 
 	// Sessions contains session configuration
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:pruning:PreserveUnknownFields
-	Sessions *runtime.RawExtension `json:"sessions,omitempty"`
+	Sessions *TenantSessions `json:"sessions,omitempty"`
 
 	// SandboxVersion specifies the sandbox version
 	// +kubebuilder:validation:Optional
@@ -145,6 +172,25 @@ type TenantConf struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=persistent;non-persistent
 	SessionCookieMode *string `json:"session_cookie_mode,omitempty"`
+
+	// CustomizeMfaInPostLoginAction enables customizing MFA factors in post-login actions
+	// +kubebuilder:validation:Optional
+	CustomizeMfaInPostLoginAction *bool `json:"customize_mfa_in_postlogin_action,omitempty"`
+
+	// AcrValuesSupported lists the ACR values supported by the tenant
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=25
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	AcrValuesSupported []string `json:"acr_values_supported,omitempty"`
+
+	// PushedAuthorizationRequestsSupported enables pushed authorization requests (PAR)
+	// +kubebuilder:validation:Optional
+	PushedAuthorizationRequestsSupported *bool `json:"pushed_authorization_requests_supported,omitempty"`
+
+	// Mtls contains mTLS configuration for the tenant
+	// +kubebuilder:validation:Optional
+	Mtls *TenantMtls `json:"mtls,omitempty"`
 }
 
 // TenantChangePassword contains change password configuration
@@ -267,6 +313,20 @@ type TenantFlags struct {
 	EnableIdTokenImplicitGrant *bool `json:"enable_impersonation,omitempty"`
 }
 
+// TenantSessions contains session configuration
+type TenantSessions struct {
+	// OidcLogoutPromptEnabled indicates whether to prompt the user before OIDC logout
+	// +kubebuilder:validation:Optional
+	OidcLogoutPromptEnabled *bool `json:"oidc_logout_prompt_enabled,omitempty"`
+}
+
+// TenantMtls contains mTLS configuration for the tenant
+type TenantMtls struct {
+	// EnableEndpointAliases enables the mTLS endpoint aliases
+	// +kubebuilder:validation:Optional
+	EnableEndpointAliases *bool `json:"enable_endpoint_aliases,omitempty"`
+}
+
 // SessionCookie contains session cookie configuration
 type SessionCookie struct {
 	// Mode specifies the session cookie mode
@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -118,6 +119,11 @@ func (in *A0ClientGrantSpec) DeepCopyInto(out *A0ClientGrantSpec) {
 		*out = make([]V1EntityPolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.TenantRef != nil {
 		in, out := &in.TenantRef, &out.TenantRef
 		*out = new(V1TenantReference)
@@ -158,6 +164,27 @@ func (in *A0ClientGrantStatus) DeepCopyInto(out *A0ClientGrantStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftBaseline != nil {
+		in, out := &in.DriftBaseline, &out.DriftBaseline
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(V1Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A0ClientGrantStatus.
@@ -210,6 +237,11 @@ func (in *A0ClientSpec) DeepCopyInto(out *A0ClientSpec) {
 		*out = make([]V1EntityPolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.TenantRef != nil {
 		in, out := &in.TenantRef, &out.TenantRef
 		*out = new(V1TenantReference)
@@ -260,6 +292,27 @@ func (in *A0ClientStatus) DeepCopyInto(out *A0ClientStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftBaseline != nil {
+		in, out := &in.DriftBaseline, &out.DriftBaseline
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(V1Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A0ClientStatus.
@@ -339,6 +392,11 @@ func (in *A0ConnectionSpec) DeepCopyInto(out *A0ConnectionSpec) {
 		*out = make([]V1EntityPolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.TenantRef != nil {
 		in, out := &in.TenantRef, &out.TenantRef
 		*out = new(V1TenantReference)
@@ -384,6 +442,27 @@ func (in *A0ConnectionStatus) DeepCopyInto(out *A0ConnectionStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftBaseline != nil {
+		in, out := &in.DriftBaseline, &out.DriftBaseline
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(V1Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A0ConnectionStatus.
@@ -463,6 +542,11 @@ func (in *A0ResourceServerSpec) DeepCopyInto(out *A0ResourceServerSpec) {
 		*out = make([]V1EntityPolicyType, len(*in))
 		copy(*out, *in)
 	}
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.TenantRef != nil {
 		in, out := &in.TenantRef, &out.TenantRef
 		*out = new(V1TenantReference)
@@ -508,6 +592,27 @@ func (in *A0ResourceServerStatus) DeepCopyInto(out *A0ResourceServerStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftBaseline != nil {
+		in, out := &in.DriftBaseline, &out.DriftBaseline
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(V1Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A0ResourceServerStatus.
@@ -622,6 +727,22 @@ func (in *A0TenantStatus) DeepCopyInto(out *A0TenantStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(V1Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A0TenantStatus.
@@ -635,437 +756,2582 @@ func (in *A0TenantStatus) DeepCopy() *A0TenantStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackchannelLogoutInitiators) DeepCopyInto(out *BackchannelLogoutInitiators) {
+func (in *AddonAws) DeepCopyInto(out *AddonAws) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
 		*out = new(string)
 		**out = **in
 	}
-	if in.SelectedInitiators != nil {
-		in, out := &in.SelectedInitiators, &out.SelectedInitiators
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.LifetimeInSeconds != nil {
+		in, out := &in.LifetimeInSeconds, &out.LifetimeInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackchannelLogoutInitiators.
-func (in *BackchannelLogoutInitiators) DeepCopy() *BackchannelLogoutInitiators {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonAws.
+func (in *AddonAws) DeepCopy() *AddonAws {
 	if in == nil {
 		return nil
 	}
-	out := new(BackchannelLogoutInitiators)
+	out := new(AddonAws)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientAuthenticationMethods) DeepCopyInto(out *ClientAuthenticationMethods) {
+func (in *AddonAzureMobileServices) DeepCopyInto(out *AddonAzureMobileServices) {
 	*out = *in
-	if in.PrivateKeyJwt != nil {
-		in, out := &in.PrivateKeyJwt, &out.PrivateKeyJwt
-		*out = new(PrivateKeyJwtDef)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TlsClientAuth != nil {
-		in, out := &in.TlsClientAuth, &out.TlsClientAuth
-		*out = new(TlsClientAuthDef)
-		(*in).DeepCopyInto(*out)
+	if in.MasterKey != nil {
+		in, out := &in.MasterKey, &out.MasterKey
+		*out = new(string)
+		**out = **in
 	}
-	if in.SelfSignedTlsClientAuth != nil {
-		in, out := &in.SelfSignedTlsClientAuth, &out.SelfSignedTlsClientAuth
-		*out = new(SelfSignedTlsClientAuthDef)
-		(*in).DeepCopyInto(*out)
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientAuthenticationMethods.
-func (in *ClientAuthenticationMethods) DeepCopy() *ClientAuthenticationMethods {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonAzureMobileServices.
+func (in *AddonAzureMobileServices) DeepCopy() *AddonAzureMobileServices {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientAuthenticationMethods)
+	out := new(AddonAzureMobileServices)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientConf) DeepCopyInto(out *ClientConf) {
+func (in *AddonAzureServiceBus) DeepCopyInto(out *AddonAzureServiceBus) {
 	*out = *in
-	if in.SigningKeys != nil {
-		in, out := &in.SigningKeys, &out.SigningKeys
-		*out = make([]SigningKey, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
 	}
-	if in.ApplicationType != nil {
-		in, out := &in.ApplicationType, &out.ApplicationType
+	if in.SasKeyName != nil {
+		in, out := &in.SasKeyName, &out.SasKeyName
 		*out = new(string)
 		**out = **in
 	}
-	if in.TokenEndpointAuthMethod != nil {
-		in, out := &in.TokenEndpointAuthMethod, &out.TokenEndpointAuthMethod
+	if in.SasKey != nil {
+		in, out := &in.SasKey, &out.SasKey
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClientAuthenticationMethods != nil {
-		in, out := &in.ClientAuthenticationMethods, &out.ClientAuthenticationMethods
-		*out = new(ClientAuthenticationMethods)
-		(*in).DeepCopyInto(*out)
+	if in.EntityPath != nil {
+		in, out := &in.EntityPath, &out.EntityPath
+		*out = new(string)
+		**out = **in
 	}
-	if in.SignedRequestObject != nil {
-		in, out := &in.SignedRequestObject, &out.SignedRequestObject
-		*out = new(SignedRequestObject)
-		(*in).DeepCopyInto(*out)
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(string)
+		**out = **in
 	}
-	if in.AddOns != nil {
-		in, out := &in.AddOns, &out.AddOns
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.AllowedClients != nil {
-		in, out := &in.AllowedClients, &out.AllowedClients
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonAzureServiceBus.
+func (in *AddonAzureServiceBus) DeepCopy() *AddonAzureServiceBus {
+	if in == nil {
+		return nil
 	}
-	if in.AllowedLogoutUrls != nil {
-		in, out := &in.AllowedLogoutUrls, &out.AllowedLogoutUrls
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(AddonAzureServiceBus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonEchoSign) DeepCopyInto(out *AddonEchoSign) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
 	}
-	if in.AllowedOrigins != nil {
-		in, out := &in.AllowedOrigins, &out.AllowedOrigins
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.WebOrigins != nil {
-		in, out := &in.WebOrigins, &out.WebOrigins
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonEchoSign.
+func (in *AddonEchoSign) DeepCopy() *AddonEchoSign {
+	if in == nil {
+		return nil
 	}
-	if in.InitiateLoginUri != nil {
-		in, out := &in.InitiateLoginUri, &out.InitiateLoginUri
+	out := new(AddonEchoSign)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonEgnyte) DeepCopyInto(out *AddonEgnyte) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
 		*out = new(string)
 		**out = **in
 	}
-	if in.Callbacks != nil {
-		in, out := &in.Callbacks, &out.Callbacks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ClientAliases != nil {
-		in, out := &in.ClientAliases, &out.ClientAliases
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.ClientMetadata != nil {
-		in, out := &in.ClientMetadata, &out.ClientMetadata
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonEgnyte.
+func (in *AddonEgnyte) DeepCopy() *AddonEgnyte {
+	if in == nil {
+		return nil
 	}
-	if in.IsCustomLoginPageOn != nil {
-		in, out := &in.IsCustomLoginPageOn, &out.IsCustomLoginPageOn
-		*out = new(bool)
-		**out = **in
+	out := new(AddonEgnyte)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonEnabled) DeepCopyInto(out *AddonEnabled) {
+	*out = *in
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.IsFirstParty != nil {
-		in, out := &in.IsFirstParty, &out.IsFirstParty
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonEnabled.
+func (in *AddonEnabled) DeepCopy() *AddonEnabled {
+	if in == nil {
+		return nil
 	}
-	if in.CustomLoginPage != nil {
-		in, out := &in.CustomLoginPage, &out.CustomLoginPage
+	out := new(AddonEnabled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonFirebase) DeepCopyInto(out *AddonFirebase) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
 		*out = new(string)
 		**out = **in
 	}
-	if in.CustomLoginPagePreview != nil {
-		in, out := &in.CustomLoginPagePreview, &out.CustomLoginPagePreview
+	if in.PrivateKeyId != nil {
+		in, out := &in.PrivateKeyId, &out.PrivateKeyId
 		*out = new(string)
 		**out = **in
 	}
-	if in.EncryptionKey != nil {
-		in, out := &in.EncryptionKey, &out.EncryptionKey
-		*out = new(EncryptionKey)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.FormTemplate != nil {
-		in, out := &in.FormTemplate, &out.FormTemplate
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
 		*out = new(string)
 		**out = **in
 	}
-	if in.GrantTypes != nil {
-		in, out := &in.GrantTypes, &out.GrantTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.JwtConfiguration != nil {
-		in, out := &in.JwtConfiguration, &out.JwtConfiguration
-		*out = new(JwtConfiguration)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Mobile != nil {
-		in, out := &in.Mobile, &out.Mobile
-		*out = new(Mobile)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.ClientEmail != nil {
+		in, out := &in.ClientEmail, &out.ClientEmail
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.LifetimeInSeconds != nil {
+		in, out := &in.LifetimeInSeconds, &out.LifetimeInSeconds
+		*out = new(int32)
 		**out = **in
 	}
-	if in.LogoUri != nil {
-		in, out := &in.LogoUri, &out.LogoUri
-		*out = new(string)
-		**out = **in
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.OidcConformant != nil {
-		in, out := &in.OidcConformant, &out.OidcConformant
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonFirebase.
+func (in *AddonFirebase) DeepCopy() *AddonFirebase {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonFirebase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonNewRelic) DeepCopyInto(out *AddonNewRelic) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
 		**out = **in
 	}
-	if in.OidcLogout != nil {
-		in, out := &in.OidcLogout, &out.OidcLogout
-		*out = new(OidcLogoutConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.ResourceServers != nil {
-		in, out := &in.ResourceServers, &out.ResourceServers
-		*out = make([]ClientResourceServerAssociation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonNewRelic.
+func (in *AddonNewRelic) DeepCopy() *AddonNewRelic {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonNewRelic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonOffice365) DeepCopyInto(out *AddonOffice365) {
+	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	if in.Sso != nil {
-		in, out := &in.Sso, &out.Sso
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonOffice365.
+func (in *AddonOffice365) DeepCopy() *AddonOffice365 {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonOffice365)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSalesForce) DeepCopyInto(out *AddonSalesForce) {
+	*out = *in
+	if in.EntityId != nil {
+		in, out := &in.EntityId, &out.EntityId
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSalesForce.
+func (in *AddonSalesForce) DeepCopy() *AddonSalesForce {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSalesForce)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSalesForceApi) DeepCopyInto(out *AddonSalesForceApi) {
+	*out = *in
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommunityName != nil {
+		in, out := &in.CommunityName, &out.CommunityName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommunityUrlSection != nil {
+		in, out := &in.CommunityUrlSection, &out.CommunityUrlSection
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSalesForceApi.
+func (in *AddonSalesForceApi) DeepCopy() *AddonSalesForceApi {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSalesForceApi)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSamlp) DeepCopyInto(out *AddonSamlp) {
+	*out = *in
+	if in.Mappings != nil {
+		in, out := &in.Mappings, &out.Mappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.Recipient != nil {
+		in, out := &in.Recipient, &out.Recipient
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateUpnClaim != nil {
+		in, out := &in.CreateUpnClaim, &out.CreateUpnClaim
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RefreshToken != nil {
-		in, out := &in.RefreshToken, &out.RefreshToken
-		*out = new(RefreshToken)
-		(*in).DeepCopyInto(*out)
+	if in.MapUnknownClaimsAsIs != nil {
+		in, out := &in.MapUnknownClaimsAsIs, &out.MapUnknownClaimsAsIs
+		*out = new(bool)
+		**out = **in
 	}
-	if in.OrganizationUsage != nil {
-		in, out := &in.OrganizationUsage, &out.OrganizationUsage
+	if in.PassthroughClaimsWithNoMapping != nil {
+		in, out := &in.PassthroughClaimsWithNoMapping, &out.PassthroughClaimsWithNoMapping
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MapIdentities != nil {
+		in, out := &in.MapIdentities, &out.MapIdentities
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SignatureAlgorithm != nil {
+		in, out := &in.SignatureAlgorithm, &out.SignatureAlgorithm
 		*out = new(string)
 		**out = **in
 	}
-	if in.OrganizationRequireBehavior != nil {
-		in, out := &in.OrganizationRequireBehavior, &out.OrganizationRequireBehavior
+	if in.DigestAlgorithm != nil {
+		in, out := &in.DigestAlgorithm, &out.DigestAlgorithm
 		*out = new(string)
 		**out = **in
 	}
-	if in.CrossOriginAuthentication != nil {
-		in, out := &in.CrossOriginAuthentication, &out.CrossOriginAuthentication
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(string)
+		**out = **in
+	}
+	if in.LifetimeInSeconds != nil {
+		in, out := &in.LifetimeInSeconds, &out.LifetimeInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SignResponse != nil {
+		in, out := &in.SignResponse, &out.SignResponse
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RequirePushedAuthorizationRequests != nil {
-		in, out := &in.RequirePushedAuthorizationRequests, &out.RequirePushedAuthorizationRequests
+	if in.NameIdentifierFormat != nil {
+		in, out := &in.NameIdentifierFormat, &out.NameIdentifierFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameIdentifierProbes != nil {
+		in, out := &in.NameIdentifierProbes, &out.NameIdentifierProbes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthnContextClassRef != nil {
+		in, out := &in.AuthnContextClassRef, &out.AuthnContextClassRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.TypedAttributes != nil {
+		in, out := &in.TypedAttributes, &out.TypedAttributes
 		*out = new(bool)
 		**out = **in
 	}
-	if in.DefaultOrganization != nil {
-		in, out := &in.DefaultOrganization, &out.DefaultOrganization
-		*out = new(DefaultOrganization)
+	if in.IncludeAttributeNameFormat != nil {
+		in, out := &in.IncludeAttributeNameFormat, &out.IncludeAttributeNameFormat
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Logout != nil {
+		in, out := &in.Logout, &out.Logout
+		*out = new(AddonSamlpLogout)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ComplianceLevel != nil {
-		in, out := &in.ComplianceLevel, &out.ComplianceLevel
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
 		*out = new(string)
 		**out = **in
 	}
-	if in.RequireProofOfPossession != nil {
-		in, out := &in.RequireProofOfPossession, &out.RequireProofOfPossession
+	if in.SigningCert != nil {
+		in, out := &in.SigningCert, &out.SigningCert
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSamlp.
+func (in *AddonSamlp) DeepCopy() *AddonSamlp {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSamlp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSamlpLogout) DeepCopyInto(out *AddonSamlpLogout) {
+	*out = *in
+	if in.Callback != nil {
+		in, out := &in.Callback, &out.Callback
+		*out = new(string)
+		**out = **in
+	}
+	if in.SloEnabled != nil {
+		in, out := &in.SloEnabled, &out.SloEnabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.EnabledConnections != nil {
-		in, out := &in.EnabledConnections, &out.EnabledConnections
-		*out = make([]V1ConnectionReference, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSamlpLogout.
+func (in *AddonSamlpLogout) DeepCopy() *AddonSamlpLogout {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSamlpLogout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSapApi) DeepCopyInto(out *AddonSapApi) {
+	*out = *in
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.UsernameAttribute != nil {
+		in, out := &in.UsernameAttribute, &out.UsernameAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenEndpointUrl != nil {
+		in, out := &in.TokenEndpointUrl, &out.TokenEndpointUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePassword != nil {
+		in, out := &in.ServicePassword, &out.ServicePassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameIdentifierFormat != nil {
+		in, out := &in.NameIdentifierFormat, &out.NameIdentifierFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSapApi.
+func (in *AddonSapApi) DeepCopy() *AddonSapApi {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSapApi)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSharePoint) DeepCopyInto(out *AddonSharePoint) {
+	*out = *in
+	if in.Url != nil {
+		in, out := &in.Url, &out.Url
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalUrl != nil {
+		in, out := &in.ExternalUrl, &out.ExternalUrl
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConf.
-func (in *ClientConf) DeepCopy() *ClientConf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSharePoint.
+func (in *AddonSharePoint) DeepCopy() *AddonSharePoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSharePoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSpringCM) DeepCopyInto(out *AddonSpringCM) {
+	*out = *in
+	if in.AcsUrl != nil {
+		in, out := &in.AcsUrl, &out.AcsUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSpringCM.
+func (in *AddonSpringCM) DeepCopy() *AddonSpringCM {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSpringCM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonZendesk) DeepCopyInto(out *AddonZendesk) {
+	*out = *in
+	if in.AccountName != nil {
+		in, out := &in.AccountName, &out.AccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonZendesk.
+func (in *AddonZendesk) DeepCopy() *AddonZendesk {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonZendesk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonZoom) DeepCopyInto(out *AddonZoom) {
+	*out = *in
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
+		**out = **in
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonZoom.
+func (in *AddonZoom) DeepCopy() *AddonZoom {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonZoom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackchannelLogoutInitiators) DeepCopyInto(out *BackchannelLogoutInitiators) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SelectedInitiators != nil {
+		in, out := &in.SelectedInitiators, &out.SelectedInitiators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackchannelLogoutInitiators.
+func (in *BackchannelLogoutInitiators) DeepCopy() *BackchannelLogoutInitiators {
+	if in == nil {
+		return nil
+	}
+	out := new(BackchannelLogoutInitiators)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientAddons) DeepCopyInto(out *ClientAddons) {
+	*out = *in
+	if in.AmazonWebServices != nil {
+		in, out := &in.AmazonWebServices, &out.AmazonWebServices
+		*out = new(AddonAws)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureMobileServices != nil {
+		in, out := &in.AzureMobileServices, &out.AzureMobileServices
+		*out = new(AddonAzureMobileServices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureServiceBus != nil {
+		in, out := &in.AzureServiceBus, &out.AzureServiceBus
+		*out = new(AddonAzureServiceBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Box != nil {
+		in, out := &in.Box, &out.Box
+		*out = new(AddonEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudBees != nil {
+		in, out := &in.CloudBees, &out.CloudBees
+		*out = new(AddonEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concur != nil {
+		in, out := &in.Concur, &out.Concur
+		*out = new(AddonEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DropBox != nil {
+		in, out := &in.DropBox, &out.DropBox
+		*out = new(AddonEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EchoSign != nil {
+		in, out := &in.EchoSign, &out.EchoSign
+		*out = new(AddonEchoSign)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egnyte != nil {
+		in, out := &in.Egnyte, &out.Egnyte
+		*out = new(AddonEgnyte)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FireBase != nil {
+		in, out := &in.FireBase, &out.FireBase
+		*out = new(AddonFirebase)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NewRelic != nil {
+		in, out := &in.NewRelic, &out.NewRelic
+		*out = new(AddonNewRelic)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Office365 != nil {
+		in, out := &in.Office365, &out.Office365
+		*out = new(AddonOffice365)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SalesForce != nil {
+		in, out := &in.SalesForce, &out.SalesForce
+		*out = new(AddonSalesForce)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SalesForceApi != nil {
+		in, out := &in.SalesForceApi, &out.SalesForceApi
+		*out = new(AddonSalesForceApi)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SalesForceSandboxApi != nil {
+		in, out := &in.SalesForceSandboxApi, &out.SalesForceSandboxApi
+		*out = new(AddonSalesForceApi)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SamlP != nil {
+		in, out := &in.SamlP, &out.SamlP
+		*out = new(AddonSamlp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SapApi != nil {
+		in, out := &in.SapApi, &out.SapApi
+		*out = new(AddonSapApi)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharePoint != nil {
+		in, out := &in.SharePoint, &out.SharePoint
+		*out = new(AddonSharePoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpringCM != nil {
+		in, out := &in.SpringCM, &out.SpringCM
+		*out = new(AddonSpringCM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebApi != nil {
+		in, out := &in.WebApi, &out.WebApi
+		*out = new(AddonEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WsFed != nil {
+		in, out := &in.WsFed, &out.WsFed
+		*out = new(AddonEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zendesk != nil {
+		in, out := &in.Zendesk, &out.Zendesk
+		*out = new(AddonZendesk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zoom != nil {
+		in, out := &in.Zoom, &out.Zoom
+		*out = new(AddonZoom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientAddons.
+func (in *ClientAddons) DeepCopy() *ClientAddons {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientAddons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientAuthenticationMethods) DeepCopyInto(out *ClientAuthenticationMethods) {
+	*out = *in
+	if in.PrivateKeyJwt != nil {
+		in, out := &in.PrivateKeyJwt, &out.PrivateKeyJwt
+		*out = new(PrivateKeyJwtDef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TlsClientAuth != nil {
+		in, out := &in.TlsClientAuth, &out.TlsClientAuth
+		*out = new(TlsClientAuthDef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelfSignedTlsClientAuth != nil {
+		in, out := &in.SelfSignedTlsClientAuth, &out.SelfSignedTlsClientAuth
+		*out = new(SelfSignedTlsClientAuthDef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientAuthenticationMethods.
+func (in *ClientAuthenticationMethods) DeepCopy() *ClientAuthenticationMethods {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientAuthenticationMethods)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConf) DeepCopyInto(out *ClientConf) {
+	*out = *in
+	if in.SigningKeys != nil {
+		in, out := &in.SigningKeys, &out.SigningKeys
+		*out = make([]SigningKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplicationType != nil {
+		in, out := &in.ApplicationType, &out.ApplicationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenEndpointAuthMethod != nil {
+		in, out := &in.TokenEndpointAuthMethod, &out.TokenEndpointAuthMethod
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientAuthenticationMethods != nil {
+		in, out := &in.ClientAuthenticationMethods, &out.ClientAuthenticationMethods
+		*out = new(ClientAuthenticationMethods)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignedRequestObject != nil {
+		in, out := &in.SignedRequestObject, &out.SignedRequestObject
+		*out = new(SignedRequestObject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddOns != nil {
+		in, out := &in.AddOns, &out.AddOns
+		*out = new(ClientAddons)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedClients != nil {
+		in, out := &in.AllowedClients, &out.AllowedClients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedLogoutUrls != nil {
+		in, out := &in.AllowedLogoutUrls, &out.AllowedLogoutUrls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WebOrigins != nil {
+		in, out := &in.WebOrigins, &out.WebOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InitiateLoginUri != nil {
+		in, out := &in.InitiateLoginUri, &out.InitiateLoginUri
+		*out = new(string)
+		**out = **in
+	}
+	if in.Callbacks != nil {
+		in, out := &in.Callbacks, &out.Callbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientAliases != nil {
+		in, out := &in.ClientAliases, &out.ClientAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientMetadata != nil {
+		in, out := &in.ClientMetadata, &out.ClientMetadata
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IsCustomLoginPageOn != nil {
+		in, out := &in.IsCustomLoginPageOn, &out.IsCustomLoginPageOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsFirstParty != nil {
+		in, out := &in.IsFirstParty, &out.IsFirstParty
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomLoginPage != nil {
+		in, out := &in.CustomLoginPage, &out.CustomLoginPage
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomLoginPagePreview != nil {
+		in, out := &in.CustomLoginPagePreview, &out.CustomLoginPagePreview
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionKey != nil {
+		in, out := &in.EncryptionKey, &out.EncryptionKey
+		*out = new(EncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FormTemplate != nil {
+		in, out := &in.FormTemplate, &out.FormTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.GrantTypes != nil {
+		in, out := &in.GrantTypes, &out.GrantTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JwtConfiguration != nil {
+		in, out := &in.JwtConfiguration, &out.JwtConfiguration
+		*out = new(JwtConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mobile != nil {
+		in, out := &in.Mobile, &out.Mobile
+		*out = new(Mobile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogoUri != nil {
+		in, out := &in.LogoUri, &out.LogoUri
+		*out = new(string)
+		**out = **in
+	}
+	if in.OidcConformant != nil {
+		in, out := &in.OidcConformant, &out.OidcConformant
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OidcLogout != nil {
+		in, out := &in.OidcLogout, &out.OidcLogout
+		*out = new(OidcLogoutConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceServers != nil {
+		in, out := &in.ResourceServers, &out.ResourceServers
+		*out = make([]ClientResourceServerAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sso != nil {
+		in, out := &in.Sso, &out.Sso
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshToken != nil {
+		in, out := &in.RefreshToken, &out.RefreshToken
+		*out = new(RefreshToken)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationUsage != nil {
+		in, out := &in.OrganizationUsage, &out.OrganizationUsage
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationRequireBehavior != nil {
+		in, out := &in.OrganizationRequireBehavior, &out.OrganizationRequireBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.CrossOriginAuthentication != nil {
+		in, out := &in.CrossOriginAuthentication, &out.CrossOriginAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequirePushedAuthorizationRequests != nil {
+		in, out := &in.RequirePushedAuthorizationRequests, &out.RequirePushedAuthorizationRequests
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultOrganization != nil {
+		in, out := &in.DefaultOrganization, &out.DefaultOrganization
+		*out = new(DefaultOrganization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComplianceLevel != nil {
+		in, out := &in.ComplianceLevel, &out.ComplianceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequireProofOfPossession != nil {
+		in, out := &in.RequireProofOfPossession, &out.RequireProofOfPossession
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnabledConnections != nil {
+		in, out := &in.EnabledConnections, &out.EnabledConnections
+		*out = make([]V1ConnectionReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConf.
+func (in *ClientConf) DeepCopy() *ClientConf {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientFind) DeepCopyInto(out *ClientFind) {
+	*out = *in
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.CallbackUrls != nil {
+		in, out := &in.CallbackUrls, &out.CallbackUrls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CallbackUrlMatchMode != nil {
+		in, out := &in.CallbackUrlMatchMode, &out.CallbackUrlMatchMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientFind.
+func (in *ClientFind) DeepCopy() *ClientFind {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientFind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientGrantConf) DeepCopyInto(out *ClientGrantConf) {
+	*out = *in
+	if in.ClientRef != nil {
+		in, out := &in.ClientRef, &out.ClientRef
+		*out = new(V1ClientReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(V1ResourceServerReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientGrantConf.
+func (in *ClientGrantConf) DeepCopy() *ClientGrantConf {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientGrantConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientResourceServerAssociation) DeepCopyInto(out *ClientResourceServerAssociation) {
+	*out = *in
+	if in.Identifier != nil {
+		in, out := &in.Identifier, &out.Identifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResourceServerAssociation.
+func (in *ClientResourceServerAssociation) DeepCopy() *ClientResourceServerAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientResourceServerAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionConf) DeepCopyInto(out *ConnectionConf) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisioningTicketUrl != nil {
+		in, out := &in.ProvisioningTicketUrl, &out.ProvisioningTicketUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Realms != nil {
+		in, out := &in.Realms, &out.Realms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShowAsButton != nil {
+		in, out := &in.ShowAsButton, &out.ShowAsButton
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsDomainConnection != nil {
+		in, out := &in.IsDomainConnection, &out.IsDomainConnection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionConf.
+func (in *ConnectionConf) DeepCopy() *ConnectionConf {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionFind) DeepCopyInto(out *ConnectionFind) {
+	*out = *in
+	if in.ConnectionId != nil {
+		in, out := &in.ConnectionId, &out.ConnectionId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionFind.
+func (in *ConnectionFind) DeepCopy() *ConnectionFind {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionFind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptions) DeepCopyInto(out *ConnectionOptions) {
+	*out = *in
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(ConnectionOptionsValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NonPersistentAttributes != nil {
+		in, out := &in.NonPersistentAttributes, &out.NonPersistentAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Precedence != nil {
+		in, out := &in.Precedence, &out.Precedence
+		*out = make([]ConnectionOptionsPrecedence, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(ConnectionOptionsAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableScriptContext != nil {
+		in, out := &in.EnableScriptContext, &out.EnableScriptContext
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableDatabaseCustomization != nil {
+		in, out := &in.EnableDatabaseCustomization, &out.EnableDatabaseCustomization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImportMode != nil {
+		in, out := &in.ImportMode, &out.ImportMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomScripts != nil {
+		in, out := &in.CustomScripts, &out.CustomScripts
+		*out = new(ConnectionOptionsCustomScripts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationMethods != nil {
+		in, out := &in.AuthenticationMethods, &out.AuthenticationMethods
+		*out = new(ConnectionOptionsAuthenticationMethods)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasskeyOptions != nil {
+		in, out := &in.PasskeyOptions, &out.PasskeyOptions
+		*out = new(ConnectionOptionsPasskeyOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(ConnectionOptionsPasswordPolicy)
+		**out = **in
+	}
+	if in.PasswordComplexityOptions != nil {
+		in, out := &in.PasswordComplexityOptions, &out.PasswordComplexityOptions
+		*out = new(ConnectionOptionsPasswordComplexityOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordHistory != nil {
+		in, out := &in.PasswordHistory, &out.PasswordHistory
+		*out = new(ConnectionOptionsPasswordHistory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordNoPersonalInfo != nil {
+		in, out := &in.PasswordNoPersonalInfo, &out.PasswordNoPersonalInfo
+		*out = new(ConnectionOptionsPasswordNoPersonalInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordDictionary != nil {
+		in, out := &in.PasswordDictionary, &out.PasswordDictionary
+		*out = new(ConnectionOptionsPasswordDictionary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApiEnableUsers != nil {
+		in, out := &in.ApiEnableUsers, &out.ApiEnableUsers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BasicProfile != nil {
+		in, out := &in.BasicProfile, &out.BasicProfile
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAdmin != nil {
+		in, out := &in.ExtAdmin, &out.ExtAdmin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtIsSuspended != nil {
+		in, out := &in.ExtIsSuspended, &out.ExtIsSuspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAgreedTerms != nil {
+		in, out := &in.ExtAgreedTerms, &out.ExtAgreedTerms
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtGroups != nil {
+		in, out := &in.ExtGroups, &out.ExtGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAssignedPlans != nil {
+		in, out := &in.ExtAssignedPlans, &out.ExtAssignedPlans
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtProfile != nil {
+		in, out := &in.ExtProfile, &out.ExtProfile
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableSelfServiceChangePassword != nil {
+		in, out := &in.DisableSelfServiceChangePassword, &out.DisableSelfServiceChangePassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UpstreamParams != nil {
+		in, out := &in.UpstreamParams, &out.UpstreamParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SetUserRootAttributes != nil {
+		in, out := &in.SetUserRootAttributes, &out.SetUserRootAttributes
+		*out = new(SetUserRootAttributes)
+		**out = **in
+	}
+	if in.GatewayAuthentication != nil {
+		in, out := &in.GatewayAuthentication, &out.GatewayAuthentication
+		*out = new(GatewayAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptions.
+func (in *ConnectionOptions) DeepCopy() *ConnectionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsAttributeAllowedTypes) DeepCopyInto(out *ConnectionOptionsAttributeAllowedTypes) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PhoneNumber != nil {
+		in, out := &in.PhoneNumber, &out.PhoneNumber
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsAttributeAllowedTypes.
+func (in *ConnectionOptionsAttributeAllowedTypes) DeepCopy() *ConnectionOptionsAttributeAllowedTypes {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsAttributeAllowedTypes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsAttributeIdentifier) DeepCopyInto(out *ConnectionOptionsAttributeIdentifier) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsAttributeIdentifier.
+func (in *ConnectionOptionsAttributeIdentifier) DeepCopy() *ConnectionOptionsAttributeIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsAttributeIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsAttributeMap) DeepCopyInto(out *ConnectionOptionsAttributeMap) {
+	*out = *in
+	if in.MappingMode != nil {
+		in, out := &in.MappingMode, &out.MappingMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserinfoScope != nil {
+		in, out := &in.UserinfoScope, &out.UserinfoScope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsAttributeMap.
+func (in *ConnectionOptionsAttributeMap) DeepCopy() *ConnectionOptionsAttributeMap {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsAttributeMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsAttributeValidation) DeepCopyInto(out *ConnectionOptionsAttributeValidation) {
+	*out = *in
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedTypes != nil {
+		in, out := &in.AllowedTypes, &out.AllowedTypes
+		*out = new(ConnectionOptionsAttributeAllowedTypes)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsAttributeValidation.
+func (in *ConnectionOptionsAttributeValidation) DeepCopy() *ConnectionOptionsAttributeValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsAttributeValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsAttributes) DeepCopyInto(out *ConnectionOptionsAttributes) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(ConnectionOptionsEmailAttribute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PhoneNumber != nil {
+		in, out := &in.PhoneNumber, &out.PhoneNumber
+		*out = new(ConnectionOptionsPhoneNumberAttribute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(ConnectionOptionsUsernameAttribute)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsAttributes.
+func (in *ConnectionOptionsAttributes) DeepCopy() *ConnectionOptionsAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsAuthenticationMethods) DeepCopyInto(out *ConnectionOptionsAuthenticationMethods) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(ConnectionOptionsPasswordAuthenticationMethod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Passkey != nil {
+		in, out := &in.Passkey, &out.Passkey
+		*out = new(ConnectionOptionsPasskeyAuthenticationMethod)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsAuthenticationMethods.
+func (in *ConnectionOptionsAuthenticationMethods) DeepCopy() *ConnectionOptionsAuthenticationMethods {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsAuthenticationMethods)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsConnectionSettings) DeepCopyInto(out *ConnectionOptionsConnectionSettings) {
+	*out = *in
+	if in.Pkce != nil {
+		in, out := &in.Pkce, &out.Pkce
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsConnectionSettings.
+func (in *ConnectionOptionsConnectionSettings) DeepCopy() *ConnectionOptionsConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsCustomScripts) DeepCopyInto(out *ConnectionOptionsCustomScripts) {
+	*out = *in
+	if in.Login != nil {
+		in, out := &in.Login, &out.Login
+		*out = new(string)
+		**out = **in
+	}
+	if in.GetUser != nil {
+		in, out := &in.GetUser, &out.GetUser
+		*out = new(string)
+		**out = **in
+	}
+	if in.Delete != nil {
+		in, out := &in.Delete, &out.Delete
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChangePassword != nil {
+		in, out := &in.ChangePassword, &out.ChangePassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(string)
+		**out = **in
+	}
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChangeUsername != nil {
+		in, out := &in.ChangeUsername, &out.ChangeUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChangeEmail != nil {
+		in, out := &in.ChangeEmail, &out.ChangeEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChangePhoneNumber != nil {
+		in, out := &in.ChangePhoneNumber, &out.ChangePhoneNumber
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsCustomScripts.
+func (in *ConnectionOptionsCustomScripts) DeepCopy() *ConnectionOptionsCustomScripts {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsCustomScripts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsEmailAttribute) DeepCopyInto(out *ConnectionOptionsEmailAttribute) {
+	*out = *in
+	if in.Identifier != nil {
+		in, out := &in.Identifier, &out.Identifier
+		*out = new(ConnectionOptionsAttributeIdentifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProfileRequired != nil {
+		in, out := &in.ProfileRequired, &out.ProfileRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Signup != nil {
+		in, out := &in.Signup, &out.Signup
+		*out = new(ConnectionOptionsEmailSignup)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsEmailAttribute.
+func (in *ConnectionOptionsEmailAttribute) DeepCopy() *ConnectionOptionsEmailAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsEmailAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsEmailSignup) DeepCopyInto(out *ConnectionOptionsEmailSignup) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ConnectionOptionsAttributeStatus)
+		**out = **in
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ConnectionOptionsVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsEmailSignup.
+func (in *ConnectionOptionsEmailSignup) DeepCopy() *ConnectionOptionsEmailSignup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsEmailSignup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsGoogleOAuth2) DeepCopyInto(out *ConnectionOptionsGoogleOAuth2) {
+	*out = *in
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedAudiences != nil {
+		in, out := &in.AllowedAudiences, &out.AllowedAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NonPersistentAttributes != nil {
+		in, out := &in.NonPersistentAttributes, &out.NonPersistentAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SetUserRootAttributes != nil {
+		in, out := &in.SetUserRootAttributes, &out.SetUserRootAttributes
+		*out = new(SetUserRootAttributes)
+		**out = **in
+	}
+	if in.UpstreamParams != nil {
+		in, out := &in.UpstreamParams, &out.UpstreamParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsGoogleOAuth2.
+func (in *ConnectionOptionsGoogleOAuth2) DeepCopy() *ConnectionOptionsGoogleOAuth2 {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsGoogleOAuth2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsIdpInitiated) DeepCopyInto(out *ConnectionOptionsIdpInitiated) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientProtocol != nil {
+		in, out := &in.ClientProtocol, &out.ClientProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientAuthorizeQuery != nil {
+		in, out := &in.ClientAuthorizeQuery, &out.ClientAuthorizeQuery
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsIdpInitiated.
+func (in *ConnectionOptionsIdpInitiated) DeepCopy() *ConnectionOptionsIdpInitiated {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsIdpInitiated)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsOidc) DeepCopyInto(out *ConnectionOptionsOidc) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiscoveryUrl != nil {
+		in, out := &in.DiscoveryUrl, &out.DiscoveryUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorizationEndpoint != nil {
+		in, out := &in.AuthorizationEndpoint, &out.AuthorizationEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenEndpoint != nil {
+		in, out := &in.TokenEndpoint, &out.TokenEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserinfoEndpoint != nil {
+		in, out := &in.UserinfoEndpoint, &out.UserinfoEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.JwksUri != nil {
+		in, out := &in.JwksUri, &out.JwksUri
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantDomain != nil {
+		in, out := &in.TenantDomain, &out.TenantDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IconUrl != nil {
+		in, out := &in.IconUrl, &out.IconUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionSettings != nil {
+		in, out := &in.ConnectionSettings, &out.ConnectionSettings
+		*out = new(ConnectionOptionsConnectionSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AttributeMap != nil {
+		in, out := &in.AttributeMap, &out.AttributeMap
+		*out = new(ConnectionOptionsAttributeMap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NonPersistentAttributes != nil {
+		in, out := &in.NonPersistentAttributes, &out.NonPersistentAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SetUserRootAttributes != nil {
+		in, out := &in.SetUserRootAttributes, &out.SetUserRootAttributes
+		*out = new(SetUserRootAttributes)
+		**out = **in
+	}
+	if in.UpstreamParams != nil {
+		in, out := &in.UpstreamParams, &out.UpstreamParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsOidc.
+func (in *ConnectionOptionsOidc) DeepCopy() *ConnectionOptionsOidc {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsOidc)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsOkta) DeepCopyInto(out *ConnectionOptionsOkta) {
+	*out = *in
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorizationEndpoint != nil {
+		in, out := &in.AuthorizationEndpoint, &out.AuthorizationEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenEndpoint != nil {
+		in, out := &in.TokenEndpoint, &out.TokenEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserinfoEndpoint != nil {
+		in, out := &in.UserinfoEndpoint, &out.UserinfoEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.JwksUri != nil {
+		in, out := &in.JwksUri, &out.JwksUri
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconUrl != nil {
+		in, out := &in.IconUrl, &out.IconUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionSettings != nil {
+		in, out := &in.ConnectionSettings, &out.ConnectionSettings
+		*out = new(ConnectionOptionsConnectionSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AttributeMap != nil {
+		in, out := &in.AttributeMap, &out.AttributeMap
+		*out = new(ConnectionOptionsAttributeMap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NonPersistentAttributes != nil {
+		in, out := &in.NonPersistentAttributes, &out.NonPersistentAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SetUserRootAttributes != nil {
+		in, out := &in.SetUserRootAttributes, &out.SetUserRootAttributes
+		*out = new(SetUserRootAttributes)
+		**out = **in
+	}
+	if in.UpstreamParams != nil {
+		in, out := &in.UpstreamParams, &out.UpstreamParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsOkta.
+func (in *ConnectionOptionsOkta) DeepCopy() *ConnectionOptionsOkta {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsOkta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasskeyAuthenticationMethod) DeepCopyInto(out *ConnectionOptionsPasskeyAuthenticationMethod) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasskeyAuthenticationMethod.
+func (in *ConnectionOptionsPasskeyAuthenticationMethod) DeepCopy() *ConnectionOptionsPasskeyAuthenticationMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasskeyAuthenticationMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasskeyOptions) DeepCopyInto(out *ConnectionOptionsPasskeyOptions) {
+	*out = *in
+	if in.ChallengeUi != nil {
+		in, out := &in.ChallengeUi, &out.ChallengeUi
+		*out = new(ChallengeUi)
+		**out = **in
+	}
+	if in.ProgressiveEnrollmentEnabled != nil {
+		in, out := &in.ProgressiveEnrollmentEnabled, &out.ProgressiveEnrollmentEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LocalEnrollmentEnabled != nil {
+		in, out := &in.LocalEnrollmentEnabled, &out.LocalEnrollmentEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasskeyOptions.
+func (in *ConnectionOptionsPasskeyOptions) DeepCopy() *ConnectionOptionsPasskeyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasskeyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasswordAuthenticationMethod) DeepCopyInto(out *ConnectionOptionsPasswordAuthenticationMethod) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasswordAuthenticationMethod.
+func (in *ConnectionOptionsPasswordAuthenticationMethod) DeepCopy() *ConnectionOptionsPasswordAuthenticationMethod {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasswordAuthenticationMethod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasswordComplexityOptions) DeepCopyInto(out *ConnectionOptionsPasswordComplexityOptions) {
+	*out = *in
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasswordComplexityOptions.
+func (in *ConnectionOptionsPasswordComplexityOptions) DeepCopy() *ConnectionOptionsPasswordComplexityOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasswordComplexityOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasswordDictionary) DeepCopyInto(out *ConnectionOptionsPasswordDictionary) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Dictionary != nil {
+		in, out := &in.Dictionary, &out.Dictionary
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasswordDictionary.
+func (in *ConnectionOptionsPasswordDictionary) DeepCopy() *ConnectionOptionsPasswordDictionary {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasswordDictionary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasswordHistory) DeepCopyInto(out *ConnectionOptionsPasswordHistory) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasswordHistory.
+func (in *ConnectionOptionsPasswordHistory) DeepCopy() *ConnectionOptionsPasswordHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasswordHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPasswordNoPersonalInfo) DeepCopyInto(out *ConnectionOptionsPasswordNoPersonalInfo) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPasswordNoPersonalInfo.
+func (in *ConnectionOptionsPasswordNoPersonalInfo) DeepCopy() *ConnectionOptionsPasswordNoPersonalInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPasswordNoPersonalInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPhoneNumberAttribute) DeepCopyInto(out *ConnectionOptionsPhoneNumberAttribute) {
+	*out = *in
+	if in.Signup != nil {
+		in, out := &in.Signup, &out.Signup
+		*out = new(ConnectionOptionsPhoneNumberSignup)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPhoneNumberAttribute.
+func (in *ConnectionOptionsPhoneNumberAttribute) DeepCopy() *ConnectionOptionsPhoneNumberAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPhoneNumberAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsPhoneNumberSignup) DeepCopyInto(out *ConnectionOptionsPhoneNumberSignup) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ConnectionOptionsAttributeStatus)
+		**out = **in
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ConnectionOptionsVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsPhoneNumberSignup.
+func (in *ConnectionOptionsPhoneNumberSignup) DeepCopy() *ConnectionOptionsPhoneNumberSignup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsPhoneNumberSignup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsSamlp) DeepCopyInto(out *ConnectionOptionsSamlp) {
+	*out = *in
+	if in.SignInEndpoint != nil {
+		in, out := &in.SignInEndpoint, &out.SignInEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.SignOutEndpoint != nil {
+		in, out := &in.SignOutEndpoint, &out.SignOutEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableSignout != nil {
+		in, out := &in.DisableSignout, &out.DisableSignout
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SigningCert != nil {
+		in, out := &in.SigningCert, &out.SigningCert
+		*out = new(string)
+		**out = **in
+	}
+	if in.SignSAMLRequest != nil {
+		in, out := &in.SignSAMLRequest, &out.SignSAMLRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SignatureAlgorithm != nil {
+		in, out := &in.SignatureAlgorithm, &out.SignatureAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.DigestAlgorithm != nil {
+		in, out := &in.DigestAlgorithm, &out.DigestAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProtocolBinding != nil {
+		in, out := &in.ProtocolBinding, &out.ProtocolBinding
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserIdAttribute != nil {
+		in, out := &in.UserIdAttribute, &out.UserIdAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.Debug != nil {
+		in, out := &in.Debug, &out.Debug
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetadataUrl != nil {
+		in, out := &in.MetadataUrl, &out.MetadataUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetadataXml != nil {
+		in, out := &in.MetadataXml, &out.MetadataXml
+		*out = new(string)
+		**out = **in
+	}
+	if in.EntityId != nil {
+		in, out := &in.EntityId, &out.EntityId
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantDomain != nil {
+		in, out := &in.TenantDomain, &out.TenantDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IconUrl != nil {
+		in, out := &in.IconUrl, &out.IconUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.FieldsMap != nil {
+		in, out := &in.FieldsMap, &out.FieldsMap
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdpInitiated != nil {
+		in, out := &in.IdpInitiated, &out.IdpInitiated
+		*out = new(ConnectionOptionsIdpInitiated)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NonPersistentAttributes != nil {
+		in, out := &in.NonPersistentAttributes, &out.NonPersistentAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SetUserRootAttributes != nil {
+		in, out := &in.SetUserRootAttributes, &out.SetUserRootAttributes
+		*out = new(SetUserRootAttributes)
+		**out = **in
+	}
+	if in.UpstreamParams != nil {
+		in, out := &in.UpstreamParams, &out.UpstreamParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsSamlp.
+func (in *ConnectionOptionsSamlp) DeepCopy() *ConnectionOptionsSamlp {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsSamlp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsUserName) DeepCopyInto(out *ConnectionOptionsUserName) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsUserName.
+func (in *ConnectionOptionsUserName) DeepCopy() *ConnectionOptionsUserName {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOptionsUserName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOptionsUsernameAttribute) DeepCopyInto(out *ConnectionOptionsUsernameAttribute) {
+	*out = *in
+	if in.Identifier != nil {
+		in, out := &in.Identifier, &out.Identifier
+		*out = new(ConnectionOptionsAttributeIdentifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProfileRequired != nil {
+		in, out := &in.ProfileRequired, &out.ProfileRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Signup != nil {
+		in, out := &in.Signup, &out.Signup
+		*out = new(ConnectionOptionsUsernameSignup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(ConnectionOptionsAttributeValidation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsUsernameAttribute.
+func (in *ConnectionOptionsUsernameAttribute) DeepCopy() *ConnectionOptionsUsernameAttribute {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientConf)
+	out := new(ConnectionOptionsUsernameAttribute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientFind) DeepCopyInto(out *ClientFind) {
+func (in *ConnectionOptionsUsernameSignup) DeepCopyInto(out *ConnectionOptionsUsernameSignup) {
 	*out = *in
-	if in.ClientId != nil {
-		in, out := &in.ClientId, &out.ClientId
-		*out = new(string)
-		**out = **in
-	}
-	if in.CallbackUrls != nil {
-		in, out := &in.CallbackUrls, &out.CallbackUrls
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.CallbackUrlMatchMode != nil {
-		in, out := &in.CallbackUrlMatchMode, &out.CallbackUrlMatchMode
-		*out = new(string)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ConnectionOptionsAttributeStatus)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientFind.
-func (in *ClientFind) DeepCopy() *ClientFind {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsUsernameSignup.
+func (in *ConnectionOptionsUsernameSignup) DeepCopy() *ConnectionOptionsUsernameSignup {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientFind)
+	out := new(ConnectionOptionsUsernameSignup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientGrantConf) DeepCopyInto(out *ClientGrantConf) {
+func (in *ConnectionOptionsValidation) DeepCopyInto(out *ConnectionOptionsValidation) {
 	*out = *in
-	if in.ClientRef != nil {
-		in, out := &in.ClientRef, &out.ClientRef
-		*out = new(V1ClientReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Audience != nil {
-		in, out := &in.Audience, &out.Audience
-		*out = new(V1ResourceServerReference)
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(ConnectionOptionsUserName)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Scope != nil {
-		in, out := &in.Scope, &out.Scope
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientGrantConf.
-func (in *ClientGrantConf) DeepCopy() *ClientGrantConf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsValidation.
+func (in *ConnectionOptionsValidation) DeepCopy() *ConnectionOptionsValidation {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientGrantConf)
+	out := new(ConnectionOptionsValidation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientResourceServerAssociation) DeepCopyInto(out *ClientResourceServerAssociation) {
+func (in *ConnectionOptionsVerification) DeepCopyInto(out *ConnectionOptionsVerification) {
 	*out = *in
-	if in.Identifier != nil {
-		in, out := &in.Identifier, &out.Identifier
-		*out = new(string)
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientResourceServerAssociation.
-func (in *ClientResourceServerAssociation) DeepCopy() *ClientResourceServerAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsVerification.
+func (in *ConnectionOptionsVerification) DeepCopy() *ConnectionOptionsVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientResourceServerAssociation)
+	out := new(ConnectionOptionsVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionConf) DeepCopyInto(out *ConnectionConf) {
+func (in *ConnectionOptionsWaad) DeepCopyInto(out *ConnectionOptionsWaad) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.ClientId != nil {
+		in, out := &in.ClientId, &out.ClientId
 		*out = new(string)
 		**out = **in
 	}
-	if in.DisplayName != nil {
-		in, out := &in.DisplayName, &out.DisplayName
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
 		*out = new(string)
 		**out = **in
 	}
-	if in.Strategy != nil {
-		in, out := &in.Strategy, &out.Strategy
+	if in.TenantDomain != nil {
+		in, out := &in.TenantDomain, &out.TenantDomain
 		*out = new(string)
 		**out = **in
 	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ProvisioningTicketUrl != nil {
-		in, out := &in.ProvisioningTicketUrl, &out.ProvisioningTicketUrl
+	if in.WaadProtocol != nil {
+		in, out := &in.WaadProtocol, &out.WaadProtocol
 		*out = new(string)
 		**out = **in
 	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.IdentityApi != nil {
+		in, out := &in.IdentityApi, &out.IdentityApi
+		*out = new(string)
+		**out = **in
 	}
-	if in.Realms != nil {
-		in, out := &in.Realms, &out.Realms
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.UseCommonEndpoint != nil {
+		in, out := &in.UseCommonEndpoint, &out.UseCommonEndpoint
+		*out = new(bool)
+		**out = **in
 	}
-	if in.ShowAsButton != nil {
-		in, out := &in.ShowAsButton, &out.ShowAsButton
+	if in.AppDomain != nil {
+		in, out := &in.AppDomain, &out.AppDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserIdAttribute != nil {
+		in, out := &in.UserIdAttribute, &out.UserIdAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxGroupsToRetrieve != nil {
+		in, out := &in.MaxGroupsToRetrieve, &out.MaxGroupsToRetrieve
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShouldTrustEmailVerifiedConnection != nil {
+		in, out := &in.ShouldTrustEmailVerifiedConnection, &out.ShouldTrustEmailVerifiedConnection
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApiEnableUsers != nil {
+		in, out := &in.ApiEnableUsers, &out.ApiEnableUsers
 		*out = new(bool)
 		**out = **in
 	}
-	if in.IsDomainConnection != nil {
-		in, out := &in.IsDomainConnection, &out.IsDomainConnection
+	if in.BasicProfile != nil {
+		in, out := &in.BasicProfile, &out.BasicProfile
 		*out = new(bool)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionConf.
-func (in *ConnectionConf) DeepCopy() *ConnectionConf {
-	if in == nil {
-		return nil
+	if in.ExtProfile != nil {
+		in, out := &in.ExtProfile, &out.ExtProfile
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(ConnectionConf)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionFind) DeepCopyInto(out *ConnectionFind) {
-	*out = *in
-	if in.ConnectionId != nil {
-		in, out := &in.ConnectionId, &out.ConnectionId
+	if in.ExtGroups != nil {
+		in, out := &in.ExtGroups, &out.ExtGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtNestedGroups != nil {
+		in, out := &in.ExtNestedGroups, &out.ExtNestedGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAdmin != nil {
+		in, out := &in.ExtAdmin, &out.ExtAdmin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtIsSuspended != nil {
+		in, out := &in.ExtIsSuspended, &out.ExtIsSuspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAgreedTerms != nil {
+		in, out := &in.ExtAgreedTerms, &out.ExtAgreedTerms
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtAssignedPlans != nil {
+		in, out := &in.ExtAssignedPlans, &out.ExtAssignedPlans
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IconUrl != nil {
+		in, out := &in.IconUrl, &out.IconUrl
 		*out = new(string)
 		**out = **in
 	}
+	if in.NonPersistentAttributes != nil {
+		in, out := &in.NonPersistentAttributes, &out.NonPersistentAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SetUserRootAttributes != nil {
+		in, out := &in.SetUserRootAttributes, &out.SetUserRootAttributes
+		*out = new(SetUserRootAttributes)
+		**out = **in
+	}
+	if in.UpstreamParams != nil {
+		in, out := &in.UpstreamParams, &out.UpstreamParams
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionFind.
-func (in *ConnectionFind) DeepCopy() *ConnectionFind {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOptionsWaad.
+func (in *ConnectionOptionsWaad) DeepCopy() *ConnectionOptionsWaad {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionFind)
+	out := new(ConnectionOptionsWaad)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1145,6 +3411,46 @@ func (in *EncryptionKey) DeepCopy() *EncryptionKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAuthentication) DeepCopyInto(out *GatewayAuthentication) {
+	*out = *in
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(string)
+		**out = **in
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretBase64Encoded != nil {
+		in, out := &in.SecretBase64Encoded, &out.SecretBase64Encoded
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAuthentication.
+func (in *GatewayAuthentication) DeepCopy() *GatewayAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JwtConfiguration) DeepCopyInto(out *JwtConfiguration) {
 	*out = *in
@@ -1810,7 +4116,7 @@ func (in *TenantConf) DeepCopyInto(out *TenantConf) {
 	}
 	if in.Sessions != nil {
 		in, out := &in.Sessions, &out.Sessions
-		*out = new(runtime.RawExtension)
+		*out = new(TenantSessions)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SandboxVersion != nil {
@@ -1838,6 +4144,26 @@ func (in *TenantConf) DeepCopyInto(out *TenantConf) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CustomizeMfaInPostLoginAction != nil {
+		in, out := &in.CustomizeMfaInPostLoginAction, &out.CustomizeMfaInPostLoginAction
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AcrValuesSupported != nil {
+		in, out := &in.AcrValuesSupported, &out.AcrValuesSupported
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PushedAuthorizationRequestsSupported != nil {
+		in, out := &in.PushedAuthorizationRequestsSupported, &out.PushedAuthorizationRequestsSupported
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mtls != nil {
+		in, out := &in.Mtls, &out.Mtls
+		*out = new(TenantMtls)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConf.
@@ -2030,6 +4356,46 @@ func (in *TenantGuardianMfaPage) DeepCopy() *TenantGuardianMfaPage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantMtls) DeepCopyInto(out *TenantMtls) {
+	*out = *in
+	if in.EnableEndpointAliases != nil {
+		in, out := &in.EnableEndpointAliases, &out.EnableEndpointAliases
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantMtls.
+func (in *TenantMtls) DeepCopy() *TenantMtls {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantMtls)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantSessions) DeepCopyInto(out *TenantSessions) {
+	*out = *in
+	if in.OidcLogoutPromptEnabled != nil {
+		in, out := &in.OidcLogoutPromptEnabled, &out.OidcLogoutPromptEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantSessions.
+func (in *TenantSessions) DeepCopy() *TenantSessions {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSessions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TlsClientAuthDef) DeepCopyInto(out *TlsClientAuthDef) {
 	*out = *in
@@ -2172,9 +4538,63 @@ func (in *V1ConnectionReference) DeepCopy() *V1ConnectionReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V1Plan) DeepCopyInto(out *V1Plan) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]V1PlanChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlanTime != nil {
+		in, out := &in.PlanTime, &out.PlanTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V1Plan.
+func (in *V1Plan) DeepCopy() *V1Plan {
+	if in == nil {
+		return nil
+	}
+	out := new(V1Plan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *V1PlanChange) DeepCopyInto(out *V1PlanChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new V1PlanChange.
+func (in *V1PlanChange) DeepCopy() *V1PlanChange {
+	if in == nil {
+		return nil
+	}
+	out := new(V1PlanChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *V1ResourceServerReference) DeepCopyInto(out *V1ResourceServerReference) {
 	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
 	if in.Identifier != nil {
 		in, out := &in.Identifier, &out.Identifier
 		*out = new(string)
@@ -0,0 +1,55 @@
+// Command auth0-fake runs the in-memory fake Auth0 Management API for local runs of the operator.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+)
+
+func main() {
+	var addr string
+	var clientID string
+	var clientSecret string
+	var tls bool
+	var rateLimit int
+	var tokenLifetime time.Duration
+	flag.StringVar(&addr, "addr", "127.0.0.1:8443", "The address the fake Management API listens on.")
+	flag.StringVar(&clientID, "client-id", fake.DefaultClientID, "The client ID accepted by the token endpoint.")
+	flag.StringVar(&clientSecret, "client-secret", fake.DefaultClientSecret, "The client secret accepted by the token endpoint.")
+	flag.BoolVar(&tls, "tls", true, "Serve HTTPS with a self-signed certificate.")
+	flag.IntVar(&rateLimit, "rate-limit", fake.DefaultRateLimit, "The number of Management API requests allowed per second.")
+	flag.DurationVar(&tokenLifetime, "token-lifetime", fake.DefaultTokenLifetime, "The lifetime of issued access tokens.")
+	flag.Parse()
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("unable to listen on %s: %v", addr, err)
+	}
+
+	s := fake.NewUnstartedServer(
+		fake.WithCredentials(clientID, clientSecret),
+		fake.WithRateLimit(rateLimit, time.Second),
+		fake.WithTokenLifetime(tokenLifetime),
+	)
+	s.Listener.Close()
+	s.Listener = listener
+	if tls {
+		s.StartTLS()
+	} else {
+		s.Start()
+	}
+	defer s.Close()
+
+	log.Printf("fake Auth0 Management API listening on %s (domain %s, client id %s)", s.URL, s.Domain(), s.ClientID)
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	<-sig
+}
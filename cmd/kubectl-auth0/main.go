@@ -0,0 +1,635 @@
+// Command kubectl-auth0 is a kubectl plugin that inspects the A0* resources of a cluster, imports existing Auth0
+// tenants into A0* manifests, converts between A0* manifests and auth0-deploy-cli exports and plans the changes
+// manifests would make to a tenant. Install it on the PATH and run it as "kubectl auth0".
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/ratelimit"
+	"github.com/seatgeek/auth0-operator/pkg/deploycli"
+	"github.com/seatgeek/auth0-operator/pkg/importer"
+	"github.com/seatgeek/auth0-operator/pkg/inspect"
+	"github.com/seatgeek/auth0-operator/pkg/manager"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+	"github.com/seatgeek/auth0-operator/pkg/plan"
+	"github.com/seatgeek/auth0-operator/pkg/tfstate"
+)
+
+const usage = `Usage: kubectl auth0 <command> [flags]
+
+Commands:
+  tree                 Show tenants with their clients, grants, connections and resource servers
+  describe KIND NAME   Show the configuration of a resource next to its last applied configuration
+  refs                 List references to A0* resources that do not exist
+  secrets              Show the credentials Secret written for each A0Client
+  import               Generate A0* manifests from the entities of an existing Auth0 tenant
+  import-tfstate STATE Generate A0* manifests from the Auth0 resources of a Terraform state file
+  convert PATH         Generate A0* manifests from an auth0-deploy-cli export
+  export               Write A0* resources in the auth0-deploy-cli format
+  plan                 Show the changes that A0* manifests would make to an Auth0 tenant
+  snapshot             Write the entities of an Auth0 tenant as a snapshot for plan
+  pending              Show the changes recorded by A0* resources in Plan mode
+
+Run "kubectl auth0 <command> -h" for the flags of a command.
+`
+
+// kubeFlags are the flags shared by the commands that read the cluster
+type kubeFlags struct {
+	kubeconfig    string
+	context       string
+	namespace     string
+	allNamespaces bool
+}
+
+func (f *kubeFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file. Defaults to $KUBECONFIG or ~/.kube/config.")
+	fs.StringVar(&f.context, "context", "", "The kubeconfig context to use.")
+	fs.StringVar(&f.namespace, "namespace", "", "The namespace to inspect. Defaults to the namespace of the context.")
+	fs.StringVar(&f.namespace, "n", "", "Shorthand for --namespace.")
+	fs.BoolVar(&f.allNamespaces, "all-namespaces", false, "Inspect all namespaces.")
+	fs.BoolVar(&f.allNamespaces, "A", false, "Shorthand for --all-namespaces.")
+}
+
+// client returns a Kubernetes client and the namespace to inspect, empty for all namespaces.
+func (f *kubeFlags) client() (client.Client, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = f.kubeconfig
+	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{CurrentContext: f.context})
+
+	restConfig, err := config.ClientConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: manager.Scheme()})
+	if err != nil {
+		return nil, "", err
+	}
+
+	namespace := f.namespace
+	if namespace == "" {
+		if namespace, _, err = config.Namespace(); err != nil {
+			return nil, "", err
+		}
+	}
+	if f.allNamespaces {
+		namespace = ""
+	}
+	return c, namespace, nil
+}
+
+// objects returns the A0* resources of the namespace to inspect.
+func (f *kubeFlags) objects(ctx context.Context) ([]client.Object, error) {
+	c, namespace, err := f.client()
+	if err != nil {
+		return nil, err
+	}
+	var objs []client.Object
+	lists := []client.ObjectList{&v1.A0TenantList{}, &v1.A0ConnectionList{}, &v1.A0ResourceServerList{}, &v1.A0ClientList{}, &v1.A0ClientGrantList{}}
+	for _, list := range lists {
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		if err := meta.EachListItem(list, func(obj runtime.Object) error {
+			objs = append(objs, obj.(client.Object))
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	var err error
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "tree":
+		err = runTree(ctx, args)
+	case "describe":
+		err = runDescribe(ctx, args)
+	case "refs":
+		err = runRefs(ctx, args)
+	case "secrets":
+		err = runSecrets(ctx, args)
+	case "import":
+		err = runImport(ctx, args)
+	case "import-tfstate":
+		err = runImportTFState(args)
+	case "convert":
+		err = runConvert(args)
+	case "export":
+		err = runExport(ctx, args)
+	case "plan":
+		err = runPlan(ctx, args)
+	case "snapshot":
+		err = runSnapshot(ctx, args)
+	case "pending":
+		err = runPending(ctx, args)
+	case "-h", "--help", "help":
+		fmt.Print(usage)
+	default:
+		err = fmt.Errorf("unknown command %q\n\n%s", command, usage)
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// inspector parses the flags of a command that reads the cluster and returns the remaining arguments.
+func inspector(name string, args []string) (*inspect.Inspector, []string, error) {
+	fs := flag.NewFlagSet("kubectl auth0 "+name, flag.ContinueOnError)
+	var kf kubeFlags
+	kf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	c, namespace, err := kf.client()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &inspect.Inspector{Client: c, Namespace: namespace}, fs.Args(), nil
+}
+
+func runTree(ctx context.Context, args []string) error {
+	i, _, err := inspector("tree", args)
+	if err != nil {
+		return err
+	}
+	return i.Tree(ctx, os.Stdout)
+}
+
+func runDescribe(ctx context.Context, args []string) error {
+	i, rest, err := inspector("describe", args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 2 {
+		return fmt.Errorf("usage: kubectl auth0 describe KIND NAME")
+	}
+	namespace := i.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	return i.Describe(ctx, os.Stdout, rest[0], types.NamespacedName{Namespace: namespace, Name: rest[1]})
+}
+
+func runRefs(ctx context.Context, args []string) error {
+	i, _, err := inspector("refs", args)
+	if err != nil {
+		return err
+	}
+	n, err := i.Refs(ctx, os.Stdout)
+	if err == nil && n > 0 {
+		err = fmt.Errorf("%d dangling references", n)
+	}
+	return err
+}
+
+func runSecrets(ctx context.Context, args []string) error {
+	i, _, err := inspector("secrets", args)
+	if err != nil {
+		return err
+	}
+	return i.Secrets(ctx, os.Stdout)
+}
+
+// apiFlags are the flags of the commands that call the Management API of a tenant
+type apiFlags struct {
+	tenant       string
+	domain       string
+	clientID     string
+	clientSecret string
+}
+
+func (f *apiFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.tenant, "tenant", "", "Read the domain and credentials from this A0Tenant of the cluster.")
+	fs.StringVar(&f.domain, "domain", "", "The domain of the tenant, when --tenant is not used.")
+	fs.StringVar(&f.clientID, "client-id", "", "The client ID of a Management API application, when --tenant is not used.")
+	fs.StringVar(&f.clientSecret, "client-secret", os.Getenv("AUTH0_CLIENT_SECRET"), "The client secret of the Management API application. Defaults to $AUTH0_CLIENT_SECRET.")
+}
+
+// client returns a Management API client for the A0Tenant of --tenant, which it also returns, or for the
+// credentials of the other flags.
+func (f *apiFlags) client(ctx context.Context, kf *kubeFlags) (*management.Client, *v1.A0Tenant, error) {
+	httpClient := &http.Client{Transport: ratelimit.NewTransport(nil)}
+	if f.tenant == "" {
+		if f.domain == "" || f.clientID == "" || f.clientSecret == "" {
+			return nil, nil, fmt.Errorf("either --tenant or --domain, --client-id and --client-secret are required")
+		}
+		access := management.NewTenantApiAccess(management.Credentials{Domain: f.domain, ClientID: f.clientID, ClientSecret: f.clientSecret}, management.WithHTTPClient(httpClient))
+		return management.NewClient(access, httpClient), nil, nil
+	}
+
+	c, namespace, err := kf.client()
+	if err != nil {
+		return nil, nil, err
+	}
+	a0tenant := &v1.A0Tenant{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: f.tenant}, a0tenant); err != nil {
+		return nil, nil, err
+	}
+	api, err := management.NewCache(c, httpClient).ClientFor(ctx, a0tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+	return api, a0tenant, nil
+}
+
+func runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 import", flag.ContinueOnError)
+	var kf kubeFlags
+	kf.register(fs)
+	var af apiFlags
+	af.register(fs)
+	var name, secretName, outputDir string
+	fs.StringVar(&name, "name", "", "The name of the generated A0Tenant. Defaults to --tenant or the first label of --domain.")
+	fs.StringVar(&secretName, "secret-name", "", "The Secret with the credentials of the generated A0Tenant. Defaults to the Secret of --tenant or <name>-auth0.")
+	fs.StringVar(&outputDir, "output-dir", "", "Write one file per resource to this directory instead of a single stream to stdout.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	api, a0tenant, err := af.client(ctx, &kf)
+	if err != nil {
+		return err
+	}
+	namespace, domain := kf.namespace, af.domain
+	if a0tenant != nil {
+		if namespace == "" {
+			namespace = a0tenant.Namespace
+		}
+		if a0tenant.Spec.Auth != nil {
+			domain = *a0tenant.Spec.Auth.Domain
+			if secretName == "" && a0tenant.Spec.Auth.SecretRef != nil {
+				secretName = a0tenant.Spec.Auth.SecretRef.Name
+			}
+		}
+		if name == "" {
+			name = a0tenant.Name
+		}
+	} else if name == "" {
+		name = strings.SplitN(domain, ".", 2)[0]
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	if secretName == "" {
+		secretName = name + "-auth0"
+	}
+
+	result, err := importer.Import(ctx, api, importer.Options{Namespace: namespace, TenantName: name, Domain: domain, SecretName: secretName})
+	if err != nil {
+		return err
+	}
+	for _, w := range result.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", w)
+	}
+	if outputDir != "" {
+		return manifest.WriteFiles(outputDir, result.Objects())
+	}
+	return manifest.Write(os.Stdout, result.Objects())
+}
+
+func runImportTFState(args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 import-tfstate", flag.ContinueOnError)
+	var namespace, domain, name, secretName, outputDir, reportPath string
+	fs.StringVar(&namespace, "namespace", "default", "The namespace of the generated resources.")
+	fs.StringVar(&namespace, "n", "default", "Shorthand for --namespace.")
+	fs.StringVar(&domain, "domain", "", "The domain of the tenant, which the state does not hold.")
+	fs.StringVar(&name, "name", "", "The name of the generated A0Tenant. Defaults to the first label of --domain.")
+	fs.StringVar(&secretName, "secret-name", "", "The Secret with the credentials of the generated A0Tenant. Defaults to <name>-auth0.")
+	fs.StringVar(&outputDir, "output-dir", "", "Write one file per resource to this directory instead of a single stream to stdout.")
+	fs.StringVar(&reportPath, "report", "", "Write the report of unmapped attributes to this file instead of stderr.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: kubectl auth0 import-tfstate [flags] STATE")
+	}
+	if name == "" {
+		name = strings.SplitN(domain, ".", 2)[0]
+	}
+	if name == "" {
+		return fmt.Errorf("--name or --domain is required")
+	}
+	if secretName == "" {
+		secretName = name + "-auth0"
+	}
+
+	state, err := tfstate.Read(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	result, report, err := tfstate.Import(state, importer.Options{Namespace: namespace, TenantName: name, Domain: domain, SecretName: secretName})
+	if err != nil {
+		return err
+	}
+
+	if reportPath != "" {
+		f, err := os.Create(reportPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := report.Write(f); err != nil {
+			return err
+		}
+	} else if !report.Empty() {
+		if err := report.Write(os.Stderr); err != nil {
+			return err
+		}
+	}
+
+	if outputDir != "" {
+		return manifest.WriteFiles(outputDir, result.Objects())
+	}
+	return manifest.Write(os.Stdout, result.Objects())
+}
+
+// stringList is a flag that may be given more than once
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+func runConvert(args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 convert", flag.ContinueOnError)
+	var namespace, configPath, domain, name, secretName, outputDir string
+	var overlays stringList
+	fs.StringVar(&namespace, "namespace", "default", "The namespace of the generated resources.")
+	fs.StringVar(&namespace, "n", "default", "Shorthand for --namespace.")
+	fs.StringVar(&configPath, "config", "", "A deploy CLI config file with the domain and the keyword values of the generated resources.")
+	fs.Var(&overlays, "overlay", "NAME=CONFIG: write a Kustomize overlay named NAME with the domain and keyword values of the config file CONFIG. May be repeated; requires --output-dir.")
+	fs.StringVar(&domain, "domain", "", "The domain of the tenant. Defaults to the domain of --config.")
+	fs.StringVar(&name, "name", "", "The name of the generated A0Tenant. Defaults to the first label of the domain.")
+	fs.StringVar(&secretName, "secret-name", "", "The Secret with the credentials of the generated A0Tenant. Defaults to <name>-auth0.")
+	fs.StringVar(&outputDir, "output-dir", "", "Write one file per resource to this directory instead of a single stream to stdout.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: kubectl auth0 convert [flags] PATH, where PATH is a tenant.yaml file or a directory")
+	}
+	if len(overlays) > 0 && outputDir == "" {
+		return fmt.Errorf("--overlay requires --output-dir")
+	}
+
+	cfg := &deploycli.Config{}
+	if configPath != "" {
+		var err error
+		if cfg, err = deploycli.ReadConfig(configPath); err != nil {
+			return err
+		}
+	}
+	if domain == "" {
+		domain = cfg.Domain
+	}
+	if name == "" {
+		name = strings.SplitN(domain, ".", 2)[0]
+	}
+	if name == "" {
+		return fmt.Errorf("--name is required when the domain is unknown")
+	}
+	if secretName == "" {
+		secretName = name + "-auth0"
+	}
+
+	assets, err := deploycli.Load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	conversion, err := deploycli.Convert(assets, cfg, deploycli.Options{Namespace: namespace, TenantName: name, Domain: domain, SecretName: secretName})
+	if err != nil {
+		return err
+	}
+	for _, w := range conversion.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", w)
+	}
+
+	switch {
+	case outputDir == "":
+		return manifest.Write(os.Stdout, conversion.Objects())
+	case len(overlays) == 0:
+		return manifest.WriteFiles(outputDir, conversion.Objects())
+	}
+	if err := deploycli.WriteBase(filepath.Join(outputDir, "base"), conversion.Objects()); err != nil {
+		return err
+	}
+	for _, overlay := range overlays {
+		overlayName, path, ok := strings.Cut(overlay, "=")
+		if !ok {
+			return fmt.Errorf("invalid --overlay %q, expected NAME=CONFIG", overlay)
+		}
+		overlayCfg, err := deploycli.ReadConfig(path)
+		if err != nil {
+			return err
+		}
+		patches, warnings := conversion.Overlay(overlayCfg)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "warning: overlay %s: %s\n", overlayName, w)
+		}
+		if err := deploycli.WriteOverlay(filepath.Join(outputDir, "overlays", overlayName), "../../base", patches); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 export", flag.ContinueOnError)
+	var kf kubeFlags
+	kf.register(fs)
+	var files stringList
+	var format, outputDir string
+	fs.Var(&files, "f", "A manifest file or directory to export instead of the resources of the cluster. May be repeated.")
+	fs.StringVar(&format, "format", "yaml", "The deploy CLI format to write: yaml or directory.")
+	fs.StringVar(&outputDir, "output-dir", "", "The directory to write the export to.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if outputDir == "" {
+		return fmt.Errorf("--output-dir is required")
+	}
+	if format != "yaml" && format != "directory" {
+		return fmt.Errorf("unknown format %q, expected yaml or directory", format)
+	}
+
+	var objs []client.Object
+	if len(files) > 0 {
+		var err error
+		if objs, err = manifest.ReadFiles(files...); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if objs, err = kf.objects(ctx); err != nil {
+			return err
+		}
+	}
+
+	assets, err := deploycli.Export(objs)
+	if err != nil {
+		return err
+	}
+	for _, w := range assets.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", w)
+	}
+	if format == "directory" {
+		return deploycli.WriteDirectory(outputDir, assets)
+	}
+	return deploycli.WriteYAML(outputDir, assets)
+}
+
+func runPlan(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 plan", flag.ContinueOnError)
+	var kf kubeFlags
+	kf.register(fs)
+	var af apiFlags
+	af.register(fs)
+	var files, previousFiles stringList
+	var snapshotPath, output string
+	var detailedExitCode bool
+	fs.Var(&files, "f", "A manifest file or directory to plan. May be repeated.")
+	fs.Var(&previousFiles, "previous", "A manifest file or directory that is currently applied. Its resources missing from -f are planned for deletion. May be repeated.")
+	fs.StringVar(&snapshotPath, "snapshot", "", "Plan against a snapshot file written by \"kubectl auth0 snapshot\" instead of the live tenant.")
+	fs.StringVar(&output, "output", "text", "The output format: text or json.")
+	fs.StringVar(&output, "o", "text", "Shorthand for --output.")
+	fs.BoolVar(&detailedExitCode, "detailed-exitcode", false, "Exit with status 2 when the plan creates, updates or deletes entities.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("at least one -f is required")
+	}
+	if output != "text" && output != "json" {
+		return fmt.Errorf("unknown output %q, expected text or json", output)
+	}
+
+	objs, err := manifest.ReadFiles(files...)
+	if err != nil {
+		return err
+	}
+	var previous []client.Object
+	if len(previousFiles) > 0 {
+		if previous, err = manifest.ReadFiles(previousFiles...); err != nil {
+			return err
+		}
+	}
+
+	var snap *plan.Snapshot
+	if snapshotPath != "" {
+		if snap, err = plan.ReadSnapshot(snapshotPath); err != nil {
+			return err
+		}
+	} else {
+		api, _, err := af.client(ctx, &kf)
+		if err != nil {
+			return err
+		}
+		if snap, err = plan.Fetch(ctx, api); err != nil {
+			return err
+		}
+	}
+
+	p, err := plan.Compute(objs, snap, plan.Options{Tenant: af.tenant, Previous: previous})
+	if err != nil {
+		return err
+	}
+	if output == "json" {
+		err = p.WriteJSON(os.Stdout)
+	} else {
+		err = p.WriteText(os.Stdout)
+	}
+	if err == nil && detailedExitCode && !p.Empty() {
+		os.Exit(2)
+	}
+	return err
+}
+
+func runSnapshot(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 snapshot", flag.ContinueOnError)
+	var kf kubeFlags
+	kf.register(fs)
+	var af apiFlags
+	af.register(fs)
+	var outputPath string
+	fs.StringVar(&outputPath, "output-file", "", "Write the snapshot to this file instead of stdout.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	api, _, err := af.client(ctx, &kf)
+	if err != nil {
+		return err
+	}
+	snap, err := plan.Fetch(ctx, api)
+	if err != nil {
+		return err
+	}
+	if outputPath == "" {
+		return snap.Write(os.Stdout)
+	}
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return snap.Write(f)
+}
+
+func runPending(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("kubectl auth0 pending", flag.ContinueOnError)
+	var kf kubeFlags
+	kf.register(fs)
+	var output string
+	fs.StringVar(&output, "output", "text", "The output format: text or json.")
+	fs.StringVar(&output, "o", "text", "Shorthand for --output.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if output != "text" && output != "json" {
+		return fmt.Errorf("unknown output %q, expected text or json", output)
+	}
+
+	objs, err := kf.objects(ctx)
+	if err != nil {
+		return err
+	}
+	p := plan.Pending(objs)
+	if output == "json" {
+		return p.WriteJSON(os.Stdout)
+	}
+	return p.WriteText(os.Stdout)
+}
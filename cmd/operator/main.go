@@ -0,0 +1,45 @@
+// Command operator runs the Go reconcilers for the A0* resources.
+package main
+
+import (
+	"flag"
+	"os"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+	"github.com/seatgeek/auth0-operator/pkg/manager"
+)
+
+var setupLog = ctrl.Log.WithName("setup")
+
+func main() {
+	var opts manager.Options
+	flag.StringVar(&opts.MetricsBindAddress, "metrics-bind-address", ":8080", "The address the metrics endpoint binds to. Use 0 to disable it.")
+	flag.StringVar(&opts.HealthProbeBindAddress, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.DurationVar(&opts.ReconcileInterval, "reconcile-interval", controller.DefaultReconcileInterval, "How often entities are reconciled with Auth0 when nothing changes.")
+	flag.StringVar(&opts.Namespace, "namespace", "", "Only reconcile resources in this namespace. All namespaces when empty.")
+	flag.StringVar(&opts.Partition, "partition", "", "Only reconcile resources with this kubernetes.auth0.com/partition annotation. Only resources without the annotation when empty.")
+	flag.BoolVar(&opts.LeaderElection, "leader-elect", false, "Enable leader election, so that one replica per partition is active.")
+	flag.StringVar(&opts.LeaderElectionNamespace, "leader-election-namespace", "", "The namespace of the leader election lease. Defaults to the namespace of the operator.")
+	flag.StringVar(&opts.LeaderElectionID, "leader-election-id", manager.DefaultLeaderElectionID, "The name of the leader election lease, followed by the partition.")
+
+	zapOpts := zap.Options{}
+	zapOpts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&zapOpts)))
+
+	mgr, err := manager.New(ctrl.GetConfigOrDie(), opts)
+	if err != nil {
+		setupLog.Error(err, "unable to create manager")
+		os.Exit(1)
+	}
+
+	setupLog.Info("starting operator", "namespace", opts.Namespace, "partition", opts.Partition)
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running operator")
+		os.Exit(1)
+	}
+}
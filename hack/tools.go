@@ -0,0 +1,10 @@
+//go:build tools
+// +build tools
+
+// Package tools tracks the code generators used by hack/update-codegen.sh so
+// that their versions are pinned in go.mod.
+package tools
+
+import (
+	_ "k8s.io/code-generator"
+)
@@ -18,18 +18,19 @@ set -o errexit
 set -o nounset
 set -o pipefail
 
-SCRIPT_ROOT=$(dirname "${BASH_SOURCE[0]}")/..
-CODEGEN_PKG=${CODEGEN_PKG:-$(cd "${SCRIPT_ROOT}"; ls -d -1 ./vendor/k8s.io/code-generator 2>/dev/null || echo ../code-generator)}
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+CODEGEN_PKG=${CODEGEN_PKG:-$(cd "${SCRIPT_ROOT}"; go list -m -f '{{.Dir}}' k8s.io/code-generator)}
 
-# generate-groups generates everything for a project with external types only, e.g. a project based
-# on CustomResourceDefinitions.
+source "${CODEGEN_PKG}/kube_codegen.sh"
 
-# Calling generate-groups with "all" generates deepcopy,client,informer,lister.
-bash "${CODEGEN_PKG}"/generate-groups.sh "client,informer,lister" \
-  github.com/seatgeek/auth0-operator/pkg/generated \
-  github.com/seatgeek/auth0-operator/api \
-  v1 \
-  --output-base "${SCRIPT_ROOT}"/../../.. \
-  --go-header-file "${SCRIPT_ROOT}"/hack/boilerplate.go.txt
+# kube_codegen.sh generates clients for every package under the input
+# directory that carries a +genclient tag, i.e. api/v1. --with-watch adds the
+# listers and shared informers on top of the typed clientset.
+kube::codegen::gen_client \
+    --output-dir "${SCRIPT_ROOT}/pkg/generated" \
+    --output-pkg "github.com/seatgeek/auth0-operator/pkg/generated" \
+    --boilerplate "${SCRIPT_ROOT}/hack/boilerplate.go.txt" \
+    --with-watch \
+    "${SCRIPT_ROOT}"
 
-echo "Code generation completed successfully"
\ No newline at end of file
+echo "Code generation completed successfully"
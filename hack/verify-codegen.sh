@@ -0,0 +1,31 @@
+#!/usr/bin/env bash
+
+# Copyright 2025 SeatGeek.
+# 
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+# 
+#     http://www.apache.org/licenses/LICENSE-2.0
+# 
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+
+"${SCRIPT_ROOT}/hack/update-codegen.sh"
+
+if ! git -C "${SCRIPT_ROOT}" diff --exit-code --quiet -- pkg/generated || \
+   [ -n "$(git -C "${SCRIPT_ROOT}" ls-files --others --exclude-standard -- pkg/generated)" ]; then
+    echo "Generated clients are out of date. Run 'make codegen' and commit the changes."
+    exit 1
+fi
+
+echo "Generated clients are up to date"
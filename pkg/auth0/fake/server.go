@@ -0,0 +1,863 @@
+// Package fake provides an in-memory implementation of the parts of the Auth0 Management API
+// used by the operator, served over net/http/httptest for integration tests and local runs.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default settings of a Server
+const (
+	DefaultClientID      = "fake-management-client"
+	DefaultClientSecret  = "fake-management-secret"
+	DefaultTokenLifetime = 24 * time.Hour
+	DefaultRateLimit     = 1000
+	DefaultRateWindow    = time.Second
+	DefaultPageSize      = 50
+	MaxPageSize          = 100
+)
+
+// Request records a request received by a Server
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Status int
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithCredentials sets the client credentials accepted by the token endpoint
+func WithCredentials(clientID, clientSecret string) Option {
+	return func(s *Server) {
+		s.ClientID = clientID
+		s.ClientSecret = clientSecret
+	}
+}
+
+// WithTokenLifetime sets the lifetime of issued access tokens
+func WithTokenLifetime(d time.Duration) Option {
+	return func(s *Server) {
+		s.tokenLifetime = d
+	}
+}
+
+// WithRateLimit sets the number of Management API requests allowed per window
+func WithRateLimit(limit int, window time.Duration) Option {
+	return func(s *Server) {
+		s.rateLimit = limit
+		s.rateWindow = window
+	}
+}
+
+// WithTenantSettings sets the initial tenant settings
+func WithTenantSettings(settings Object) Option {
+	return func(s *Server) {
+		s.tenantSettings = cloneObject(settings)
+	}
+}
+
+// WithClock sets the function used to tell the current time
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// Server is an in-memory Auth0 tenant exposing the Management API and the client credentials token endpoint
+type Server struct {
+	*httptest.Server
+
+	// ClientID and ClientSecret are the credentials accepted by the token endpoint
+	ClientID     string
+	ClientSecret string
+
+	mu             sync.Mutex
+	now            func() time.Time
+	collections    map[Collection]*collection
+	tenantSettings Object
+	tokens         map[string]time.Time
+	tokenLifetime  time.Duration
+	tokenRequests  int
+	requests       []Request
+
+	rateLimit     int
+	rateWindow    time.Duration
+	rateRemaining int
+	rateReset     time.Time
+	injected429s  int
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := NewUnstartedServer(opts...)
+	s.Start()
+	return s
+}
+
+// NewUnstartedServer returns a new Server that is not started yet, so that its
+// listener or TLS configuration can be changed before calling Start or StartTLS.
+func NewUnstartedServer(opts ...Option) *Server {
+	s := &Server{
+		ClientID:      DefaultClientID,
+		ClientSecret:  DefaultClientSecret,
+		now:           time.Now,
+		collections:   map[Collection]*collection{},
+		tokens:        map[string]time.Time{},
+		tokenLifetime: DefaultTokenLifetime,
+		rateLimit:     DefaultRateLimit,
+		rateWindow:    DefaultRateWindow,
+		tenantSettings: Object{
+			"friendly_name":   "Fake Tenant",
+			"enabled_locales": []interface{}{"en"},
+			"flags":           Object{},
+			"sandbox_version": "18",
+		},
+	}
+	for _, c := range []Collection{Clients, Connections, ClientGrants, ResourceServers} {
+		s.collections[c] = newCollection()
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewUnstartedServer(s.routes())
+	return s
+}
+
+// Domain returns the host and port of the server, suitable for TenantAuth.Domain
+func (s *Server) Domain() string {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// Audience returns the Management API audience expected by the token endpoint
+func (s *Server) Audience() string {
+	return s.URL + "/api/v2/"
+}
+
+// InjectRateLimits makes the next n Management API requests fail with 429 Too Many Requests
+func (s *Server) InjectRateLimits(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.injected429s += n
+}
+
+// RevokeTokens invalidates all issued access tokens, so that subsequent requests fail with 401 Unauthorized
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = map[string]time.Time{}
+}
+
+// TokenRequests returns the number of successful token requests
+func (s *Server) TokenRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokenRequests
+}
+
+// Requests returns the requests received by the server, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ResetRequests clears the recorded requests
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// Seed stores obj in collection c as-is, generating an ID if it has none, and returns its ID
+func (s *Server) Seed(c Collection, obj Object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj = cloneObject(obj)
+	id, _ := obj[c.idField()].(string)
+	if id == "" {
+		id = s.newEntityID(c)
+		obj[c.idField()] = id
+	}
+	s.collections[c].put(id, obj)
+	return id
+}
+
+// Get returns a copy of the entity with the given ID in collection c
+func (s *Server) Get(c Collection, id string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collections[c].get(id)
+	if !ok {
+		return nil, false
+	}
+	return cloneObject(obj), true
+}
+
+// List returns copies of all entities in collection c, in insertion order
+func (s *Server) List(c Collection) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objs := s.collections[c].list(nil)
+	for i := range objs {
+		objs[i] = cloneObject(objs[i])
+	}
+	return objs
+}
+
+// TenantSettings returns a copy of the tenant settings
+func (s *Server) TenantSettings() Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneObject(s.tenantSettings)
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", s.handleToken)
+
+	api := http.NewServeMux()
+	api.HandleFunc("GET /api/v2/tenants/settings", s.handleGetTenantSettings)
+	api.HandleFunc("PATCH /api/v2/tenants/settings", s.handlePatchTenantSettings)
+	for _, r := range []struct {
+		path string
+		c    Collection
+	}{
+		{"/api/v2/clients", Clients},
+		{"/api/v2/connections", Connections},
+		{"/api/v2/client-grants", ClientGrants},
+		{"/api/v2/resource-servers", ResourceServers},
+	} {
+		c := r.c
+		api.HandleFunc("GET "+r.path, func(w http.ResponseWriter, req *http.Request) { s.handleList(w, req, c) })
+		api.HandleFunc("POST "+r.path, func(w http.ResponseWriter, req *http.Request) { s.handleCreate(w, req, c) })
+		api.HandleFunc("GET "+r.path+"/{id}", func(w http.ResponseWriter, req *http.Request) { s.handleGet(w, req, c) })
+		api.HandleFunc("PATCH "+r.path+"/{id}", func(w http.ResponseWriter, req *http.Request) { s.handlePatch(w, req, c) })
+		api.HandleFunc("DELETE "+r.path+"/{id}", func(w http.ResponseWriter, req *http.Request) { s.handleDelete(w, req, c) })
+	}
+	api.HandleFunc("GET /api/v2/clients/{id}/connections", s.handleGetClientConnections)
+	api.HandleFunc("PATCH /api/v2/connections/{id}/clients", s.handlePatchConnectionClients)
+	mux.Handle("/api/v2/", s.management(api))
+
+	return s.record(mux)
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// record appends every request and its response status to the request log.
+func (s *Server) record(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Status: rec.status})
+		s.mu.Unlock()
+	})
+}
+
+// management applies rate limiting and bearer token authentication to the Management API.
+func (s *Server) management(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.takeRateLimit(w) {
+			writeError(w, http.StatusTooManyRequests, "too_many_requests", "Global limit has been reached")
+			return
+		}
+		if !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, "invalid_token", "Invalid token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// takeRateLimit consumes a request from the rate limit window and writes the x-ratelimit-* headers.
+// It reports false if the request must be rejected with 429 Too Many Requests.
+func (s *Server) takeRateLimit(w http.ResponseWriter) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if !now.Before(s.rateReset) {
+		s.rateRemaining = s.rateLimit
+		s.rateReset = now.Add(s.rateWindow)
+	}
+
+	allowed := true
+	switch {
+	case s.injected429s > 0:
+		s.injected429s--
+		allowed = false
+	case s.rateRemaining <= 0:
+		allowed = false
+	default:
+		s.rateRemaining--
+	}
+
+	remaining := s.rateRemaining
+	if !allowed {
+		remaining = 0
+	}
+
+	h := w.Header()
+	h.Set("X-RateLimit-Limit", strconv.Itoa(s.rateLimit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(s.rateReset.Unix(), 10))
+	if !allowed {
+		retryAfter := int(s.rateReset.Sub(now).Round(time.Second) / time.Second)
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		h.Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+	return allowed
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expires, ok := s.tokens[token]
+	return ok && s.now().Before(expires)
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed request body")
+			return
+		}
+	} else {
+		if err := r.ParseForm(); err != nil {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed request body")
+			return
+		}
+		for k := range r.PostForm {
+			params[k] = r.PostForm.Get(k)
+		}
+	}
+
+	if params["grant_type"] != "client_credentials" {
+		writeOAuthError(w, http.StatusForbidden, "unsupported_grant_type", "Unsupported grant type: "+params["grant_type"])
+		return
+	}
+	if params["client_id"] != s.ClientID || params["client_secret"] != s.ClientSecret {
+		writeOAuthError(w, http.StatusUnauthorized, "access_denied", "Unauthorized")
+		return
+	}
+	if !strings.HasSuffix(params["audience"], "/api/v2/") {
+		writeOAuthError(w, http.StatusForbidden, "access_denied", "Service not enabled within domain: "+params["audience"])
+		return
+	}
+
+	token := newID("", 48)
+	s.mu.Lock()
+	s.tokens[token] = s.now().Add(s.tokenLifetime)
+	s.tokenRequests++
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, Object{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int(s.tokenLifetime / time.Second),
+		"scope":        "read:clients create:clients update:clients delete:clients",
+	})
+}
+
+func (s *Server) handleGetTenantSettings(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	settings := cloneObject(s.tenantSettings)
+	s.mu.Unlock()
+
+	q := r.URL.Query()
+	writeJSON(w, http.StatusOK, project(settings, q.Get("fields"), q.Get("include_fields") != "false"))
+}
+
+func (s *Server) handlePatchTenantSettings(w http.ResponseWriter, r *http.Request) {
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	mergePatch(s.tenantSettings, patch)
+	settings := cloneObject(s.tenantSettings)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, settings)
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request, c Collection) {
+	q := r.URL.Query()
+
+	page, err := queryInt(q, "page", 0)
+	if err != nil || page < 0 {
+		writeError(w, http.StatusBadRequest, "invalid_query_string", "Query validation error: 'page' must be a non-negative integer")
+		return
+	}
+	perPage, err := queryInt(q, "per_page", DefaultPageSize)
+	if err != nil || perPage < 0 || perPage > MaxPageSize {
+		writeError(w, http.StatusBadRequest, "invalid_query_string", fmt.Sprintf("Query validation error: 'per_page' must be between 0 and %d", MaxPageSize))
+		return
+	}
+
+	s.mu.Lock()
+	all := s.collections[c].list(listFilter(c, q))
+	for i := range all {
+		all[i] = cloneObject(all[i])
+	}
+	s.mu.Unlock()
+
+	start := page * perPage
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + perPage
+	if end > len(all) {
+		end = len(all)
+	}
+
+	include := q.Get("include_fields") != "false"
+	items := make([]Object, 0, end-start)
+	for _, obj := range all[start:end] {
+		items = append(items, project(obj, q.Get("fields"), include))
+	}
+
+	if q.Get("include_totals") != "true" {
+		writeJSON(w, http.StatusOK, items)
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"start":   start,
+		"limit":   perPage,
+		"length":  len(items),
+		"total":   len(all),
+		string(c): items,
+	})
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, r *http.Request, c Collection) {
+	s.mu.Lock()
+	obj, ok := s.lookup(c, r.PathValue("id"))
+	if ok {
+		obj = cloneObject(obj)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		writeNotFound(w, c)
+		return
+	}
+	q := r.URL.Query()
+	writeJSON(w, http.StatusOK, project(obj, q.Get("fields"), q.Get("include_fields") != "false"))
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request, c Collection) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := body[c.idField()]; exists && c != Clients {
+		writeError(w, http.StatusBadRequest, "invalid_body", fmt.Sprintf("Payload validation error: 'Additional properties not allowed: %s'", c.idField()))
+		return
+	}
+	if status, code, msg := s.validateCreate(c, body); status != 0 {
+		writeError(w, status, code, msg)
+		return
+	}
+
+	obj := createDefaults(c)
+	mergePatch(obj, body)
+	id := s.newEntityID(c)
+	obj[c.idField()] = id
+	if c == Clients {
+		obj["client_secret"] = newID("", 64)
+	}
+	s.collections[c].put(id, obj)
+
+	writeJSON(w, http.StatusCreated, cloneObject(obj))
+}
+
+func (s *Server) handlePatch(w http.ResponseWriter, r *http.Request, c Collection) {
+	patch, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.lookup(c, r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, c)
+		return
+	}
+	for _, k := range readOnlyFields(c) {
+		if _, exists := patch[k]; exists {
+			writeError(w, http.StatusBadRequest, "invalid_body", fmt.Sprintf("Payload validation error: 'Additional properties not allowed: %s'", k))
+			return
+		}
+	}
+
+	mergePatch(obj, patch)
+	writeJSON(w, http.StatusOK, cloneObject(obj))
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request, c Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.lookup(c, r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, c)
+		return
+	}
+	id, _ := obj[c.idField()].(string)
+	s.collections[c].delete(id)
+
+	// deleting a client also removes it from the enabled clients of connections
+	if c == Clients {
+		for _, conn := range s.collections[Connections].list(func(o Object) bool { return containsString(o["enabled_clients"], id) }) {
+			conn["enabled_clients"] = setEnabledClient(conn["enabled_clients"], id, false)
+		}
+	}
+
+	// deleting a client or resource server also deletes its grants
+	if c == Clients || c == ResourceServers {
+		field, value := "client_id", id
+		if c == ResourceServers {
+			field, value = "audience", stringField(obj, "identifier")
+		}
+		for _, g := range s.collections[ClientGrants].list(func(g Object) bool { return stringField(g, field) == value }) {
+			s.collections[ClientGrants].delete(stringField(g, "id"))
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleGetClientConnections lists the connections whose enabled_clients contain the client. All connections are
+// returned at once, without a next checkpoint.
+func (s *Server) handleGetClientConnections(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.collections[Clients].get(id); !ok {
+		writeNotFound(w, Clients)
+		return
+	}
+	connections := []Object{}
+	for _, conn := range s.collections[Connections].list(func(o Object) bool { return containsString(o["enabled_clients"], id) }) {
+		connections = append(connections, cloneObject(conn))
+	}
+	writeJSON(w, http.StatusOK, Object{"connections": connections})
+}
+
+// handlePatchConnectionClients enables and disables clients of a connection from a list of client_id and status pairs.
+func (s *Server) handlePatchConnectionClients(w http.ResponseWriter, r *http.Request) {
+	var changes []struct {
+		ClientID string `json:"client_id"`
+		Status   *bool  `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", "Invalid request payload JSON format")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn, ok := s.lookup(Connections, r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, Connections)
+		return
+	}
+	for _, change := range changes {
+		if change.ClientID == "" || change.Status == nil {
+			writeError(w, http.StatusBadRequest, "invalid_body", "Payload validation error: 'Missing required property: client_id or status'")
+			return
+		}
+		if _, ok := s.collections[Clients].get(change.ClientID); !ok {
+			writeError(w, http.StatusNotFound, "inexistent_client", "Client not found")
+			return
+		}
+	}
+
+	for _, change := range changes {
+		conn["enabled_clients"] = setEnabledClient(conn["enabled_clients"], change.ClientID, *change.Status)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setEnabledClient adds or removes clientID from an enabled_clients list.
+func setEnabledClient(list interface{}, clientID string, enabled bool) []interface{} {
+	out := []interface{}{}
+	items, _ := list.([]interface{})
+	for _, item := range items {
+		if item != clientID {
+			out = append(out, item)
+		}
+	}
+	if enabled {
+		out = append(out, clientID)
+	}
+	return out
+}
+
+func containsString(list interface{}, value string) bool {
+	items, _ := list.([]interface{})
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the stored entity with the given ID. Resource servers can also be looked up by identifier.
+// The caller must hold s.mu.
+func (s *Server) lookup(c Collection, id string) (Object, bool) {
+	if obj, ok := s.collections[c].get(id); ok {
+		return obj, true
+	}
+	if c == ResourceServers {
+		return s.collections[c].find(func(o Object) bool { return stringField(o, "identifier") == id })
+	}
+	return nil, false
+}
+
+// validateCreate checks the required fields and uniqueness constraints of a new entity.
+// The caller must hold s.mu.
+func (s *Server) validateCreate(c Collection, body Object) (int, string, string) {
+	var required []string
+	switch c {
+	case Clients:
+		required = []string{"name"}
+	case Connections:
+		required = []string{"name", "strategy"}
+	case ClientGrants:
+		required = []string{"client_id", "audience", "scope"}
+	case ResourceServers:
+		required = []string{"identifier"}
+	}
+	for _, k := range required {
+		if _, ok := body[k]; !ok {
+			return http.StatusBadRequest, "invalid_body", fmt.Sprintf("Payload validation error: 'Missing required property: %s'", k)
+		}
+	}
+
+	switch c {
+	case Connections:
+		name := stringField(body, "name")
+		if _, exists := s.collections[c].find(func(o Object) bool { return stringField(o, "name") == name }); exists {
+			return http.StatusConflict, "connection_conflict", "A connection with the same name already exists"
+		}
+	case ResourceServers:
+		identifier := stringField(body, "identifier")
+		if _, exists := s.collections[c].find(func(o Object) bool { return stringField(o, "identifier") == identifier }); exists {
+			return http.StatusConflict, "operation_not_supported", "A resource server with the same identifier already exists"
+		}
+	case ClientGrants:
+		clientID, audience := stringField(body, "client_id"), stringField(body, "audience")
+		if _, ok := s.collections[Clients].get(clientID); !ok {
+			return http.StatusNotFound, "inexistent_client", "Client not found"
+		}
+		if _, ok := s.lookup(ResourceServers, audience); !ok {
+			return http.StatusNotFound, "inexistent_resource_server", "No resource server found by specified audience"
+		}
+		if _, exists := s.collections[c].find(func(o Object) bool {
+			return stringField(o, "client_id") == clientID && stringField(o, "audience") == audience
+		}); exists {
+			return http.StatusConflict, "client_grant_conflict", "A client grant for the client and audience already exists"
+		}
+	}
+	return 0, "", ""
+}
+
+// newEntityID returns an unused ID for collection c. The caller must hold s.mu.
+func (s *Server) newEntityID(c Collection) string {
+	length := 16
+	switch c {
+	case Clients:
+		length = 32
+	case ResourceServers:
+		length = 24
+	}
+	for {
+		id := newID(c.idPrefix(), length)
+		if _, exists := s.collections[c].get(id); !exists {
+			return id
+		}
+	}
+}
+
+// createDefaults returns the fields the Management API sets on new entities when they are not provided.
+func createDefaults(c Collection) Object {
+	switch c {
+	case Clients:
+		return Object{
+			"callbacks":                  []interface{}{},
+			"allowed_origins":            []interface{}{},
+			"is_first_party":             true,
+			"oidc_conformant":            true,
+			"token_endpoint_auth_method": "client_secret_post",
+			"jwt_configuration":          Object{"alg": "RS256", "lifetime_in_seconds": 36000, "secret_encoded": false},
+		}
+	case Connections:
+		return Object{
+			"options":              Object{},
+			"is_domain_connection": false,
+			"realms":               []interface{}{},
+			"enabled_clients":      []interface{}{},
+		}
+	case ResourceServers:
+		return Object{
+			"scopes":                 []interface{}{},
+			"signing_alg":            "RS256",
+			"allow_offline_access":   false,
+			"token_lifetime":         86400,
+			"token_lifetime_for_web": 7200,
+			"skip_consent_for_verifiable_first_party_clients": false,
+		}
+	}
+	return Object{}
+}
+
+// readOnlyFields returns the fields of entities in c that cannot be changed with PATCH.
+func readOnlyFields(c Collection) []string {
+	switch c {
+	case Clients:
+		return []string{"client_id"}
+	case Connections:
+		return []string{"id", "name", "strategy"}
+	case ClientGrants:
+		return []string{"id", "client_id", "audience"}
+	case ResourceServers:
+		return []string{"id", "identifier"}
+	}
+	return nil
+}
+
+// listFilter returns the filter applied to list requests on c, based on the query parameters the Management API supports.
+func listFilter(c Collection, q url.Values) func(Object) bool {
+	match := func(obj Object, field string, values []string) bool {
+		if len(values) == 0 {
+			return true
+		}
+		v := stringField(obj, field)
+		for _, value := range values {
+			for _, allowed := range strings.Split(value, ",") {
+				if v == allowed {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	switch c {
+	case Clients:
+		return func(o Object) bool { return match(o, "app_type", q["app_type"]) }
+	case Connections:
+		return func(o Object) bool { return match(o, "strategy", q["strategy"]) && match(o, "name", q["name"]) }
+	case ClientGrants:
+		return func(o Object) bool {
+			return match(o, "client_id", q["client_id"]) && match(o, "audience", q["audience"])
+		}
+	case ResourceServers:
+		return func(o Object) bool { return match(o, "identifier", q["identifiers"]) }
+	}
+	return nil
+}
+
+func stringField(obj Object, field string) string {
+	v, _ := obj[field].(string)
+	return v
+}
+
+func queryInt(q url.Values, key string, def int) (int, error) {
+	v := q.Get(key)
+	if v == "" {
+		return def, nil
+	}
+	return strconv.Atoi(v)
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	var obj Object
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil || obj == nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", "Payload validation error: 'Expected type object'")
+		return nil, false
+	}
+	return obj, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format of the Management API.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, Object{
+		"statusCode": status,
+		"error":      http.StatusText(status),
+		"message":    message,
+		"errorCode":  code,
+	})
+}
+
+// writeOAuthError writes an error in the format of the Authentication API.
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, Object{
+		"error":             code,
+		"error_description": description,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, c Collection) {
+	codes := map[Collection]string{
+		Clients:         "inexistent_client",
+		Connections:     "inexistent_connection",
+		ClientGrants:    "inexistent_client_grant",
+		ResourceServers: "inexistent_resource_server",
+	}
+	writeError(w, http.StatusNotFound, codes[c], "The "+strings.ReplaceAll(strings.TrimSuffix(string(c), "s"), "_", " ")+" does not exist")
+}
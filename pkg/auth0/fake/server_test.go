@@ -0,0 +1,216 @@
+package fake_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+)
+
+func token(t *testing.T, s *fake.Server) string {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     s.ClientID,
+		"client_secret": s.ClientSecret,
+		"audience":      s.Audience(),
+	})
+	resp, err := http.Post(s.URL+"/oauth/token", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("token request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from token endpoint, got %d", resp.StatusCode)
+	}
+
+	var out struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatalf("failed to decode token response: %v", err)
+	}
+	return out.AccessToken
+}
+
+func do(t *testing.T, s *fake.Server, tok, method, path string, body interface{}, out interface{}) *http.Response {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatalf("failed to encode body: %v", err)
+		}
+	}
+	req, err := http.NewRequest(method, s.URL+path, &buf)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if tok != "" {
+		req.Header.Set("Authorization", "Bearer "+tok)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+	}
+	return resp
+}
+
+func TestTokenRequired(t *testing.T) {
+	s := fake.NewServer()
+	defer s.Close()
+
+	if resp := do(t, s, "", http.MethodGet, "/api/v2/clients", nil, nil); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", resp.StatusCode)
+	}
+
+	tok := token(t, s)
+	if resp := do(t, s, tok, http.MethodGet, "/api/v2/clients", nil, nil); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 with token, got %d", resp.StatusCode)
+	}
+
+	s.RevokeTokens()
+	if resp := do(t, s, tok, http.MethodGet, "/api/v2/clients", nil, nil); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with revoked token, got %d", resp.StatusCode)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	s := fake.NewServer()
+	defer s.Close()
+	tok := token(t, s)
+
+	for i := 0; i < 250; i++ {
+		s.Seed(fake.Connections, fake.Object{"name": fmt.Sprintf("conn-%d", i), "strategy": "auth0"})
+	}
+
+	var names []string
+	for page := 0; ; page++ {
+		var out struct {
+			Start       int           `json:"start"`
+			Length      int           `json:"length"`
+			Total       int           `json:"total"`
+			Connections []fake.Object `json:"connections"`
+		}
+		q := url.Values{"page": {fmt.Sprint(page)}, "per_page": {"100"}, "include_totals": {"true"}}
+		do(t, s, tok, http.MethodGet, "/api/v2/connections?"+q.Encode(), nil, &out)
+		for _, c := range out.Connections {
+			names = append(names, c["name"].(string))
+		}
+		if out.Start+out.Length >= out.Total {
+			break
+		}
+	}
+
+	if len(names) != 250 || names[0] != "conn-0" || names[249] != "conn-249" {
+		t.Fatalf("unexpected paged result: %d entries", len(names))
+	}
+}
+
+func TestClientGrantFilters(t *testing.T) {
+	s := fake.NewServer()
+	defer s.Close()
+	tok := token(t, s)
+
+	s.Seed(fake.ClientGrants, fake.Object{"client_id": "app-1", "audience": "https://a.example.com"})
+	s.Seed(fake.ClientGrants, fake.Object{"client_id": "app-1", "audience": "https://b.example.com"})
+	s.Seed(fake.ClientGrants, fake.Object{"client_id": "app-2", "audience": "https://a.example.com"})
+
+	for _, tc := range []struct {
+		query url.Values
+		want  int
+	}{
+		{query: url.Values{}, want: 3},
+		{query: url.Values{"client_id": {"app-1"}}, want: 2},
+		{query: url.Values{"audience": {"https://a.example.com"}}, want: 2},
+		{query: url.Values{"client_id": {"app-1"}, "audience": {"https://a.example.com"}}, want: 1},
+		{query: url.Values{"client_id": {"app-3"}}, want: 0},
+	} {
+		var out []fake.Object
+		do(t, s, tok, http.MethodGet, "/api/v2/client-grants?"+tc.query.Encode(), nil, &out)
+		if len(out) != tc.want {
+			t.Errorf("%s: got %d client grants, want %d", tc.query.Encode(), len(out), tc.want)
+		}
+	}
+}
+
+func TestCrud(t *testing.T) {
+	s := fake.NewServer()
+	defer s.Close()
+	tok := token(t, s)
+
+	var rs fake.Object
+	if resp := do(t, s, tok, http.MethodPost, "/api/v2/resource-servers", fake.Object{"identifier": "https://api.example.com"}, &rs); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	if rs["signing_alg"] != "RS256" {
+		t.Fatalf("expected server defaults, got %v", rs)
+	}
+	if resp := do(t, s, tok, http.MethodPost, "/api/v2/resource-servers", fake.Object{"identifier": "https://api.example.com"}, nil); resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for duplicate identifier, got %d", resp.StatusCode)
+	}
+
+	var client fake.Object
+	do(t, s, tok, http.MethodPost, "/api/v2/clients", fake.Object{"name": "app"}, &client)
+	clientID := client["client_id"].(string)
+	if client["client_secret"] == "" {
+		t.Fatalf("expected a generated client secret")
+	}
+
+	var grant fake.Object
+	if resp := do(t, s, tok, http.MethodPost, "/api/v2/client-grants", fake.Object{"client_id": clientID, "audience": "https://api.example.com", "scope": []string{}}, &grant); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201 for client grant, got %d", resp.StatusCode)
+	}
+
+	var patched fake.Object
+	do(t, s, tok, http.MethodPatch, "/api/v2/clients/"+clientID, fake.Object{"description": "updated"}, &patched)
+	if patched["description"] != "updated" || patched["name"] != "app" {
+		t.Fatalf("unexpected patched client: %v", patched)
+	}
+
+	if resp := do(t, s, tok, http.MethodGet, "/api/v2/resource-servers/"+url.PathEscape("https://api.example.com"), nil, nil); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected resource server lookup by identifier, got %d", resp.StatusCode)
+	}
+
+	if resp := do(t, s, tok, http.MethodDelete, "/api/v2/clients/"+clientID, nil, nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	if grants := s.List(fake.ClientGrants); len(grants) != 0 {
+		t.Fatalf("expected client grants of deleted client to be removed, got %v", grants)
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	s := fake.NewServer()
+	defer s.Close()
+	tok := token(t, s)
+
+	s.InjectRateLimits(1)
+
+	resp := do(t, s, tok, http.MethodGet, "/api/v2/tenants/settings", nil, nil)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", resp.StatusCode)
+	}
+	for _, h := range []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After"} {
+		if resp.Header.Get(h) == "" {
+			t.Fatalf("expected %s header on 429", h)
+		}
+	}
+
+	if resp := do(t, s, tok, http.MethodGet, "/api/v2/tenants/settings", nil, nil); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 after injected rate limit, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,150 @@
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// Object is an Auth0 entity as it is represented on the wire
+type Object = map[string]interface{}
+
+// Collection identifies a paged Management API collection
+type Collection string
+
+const (
+	Clients         Collection = "clients"
+	Connections     Collection = "connections"
+	ClientGrants    Collection = "client_grants"
+	ResourceServers Collection = "resource_servers"
+)
+
+// idField returns the field holding the ID of the entities in c.
+func (c Collection) idField() string {
+	if c == Clients {
+		return "client_id"
+	}
+	return "id"
+}
+
+// idPrefix returns the prefix of generated IDs in c, mirroring the format of Auth0 IDs.
+func (c Collection) idPrefix() string {
+	switch c {
+	case Connections:
+		return "con_"
+	case ClientGrants:
+		return "cgr_"
+	}
+	return ""
+}
+
+// collection holds the entities of a single collection in insertion order.
+type collection struct {
+	ids     []string
+	objects map[string]Object
+}
+
+func newCollection() *collection {
+	return &collection{objects: map[string]Object{}}
+}
+
+func (c *collection) get(id string) (Object, bool) {
+	obj, ok := c.objects[id]
+	return obj, ok
+}
+
+func (c *collection) put(id string, obj Object) {
+	if _, ok := c.objects[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.objects[id] = obj
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.objects[id]; !ok {
+		return false
+	}
+	delete(c.objects, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// list returns the entities matching filter in insertion order.
+func (c *collection) list(filter func(Object) bool) []Object {
+	out := make([]Object, 0, len(c.ids))
+	for _, id := range c.ids {
+		obj := c.objects[id]
+		if filter == nil || filter(obj) {
+			out = append(out, obj)
+		}
+	}
+	return out
+}
+
+// find returns the first entity matching filter.
+func (c *collection) find(filter func(Object) bool) (Object, bool) {
+	for _, id := range c.ids {
+		if obj := c.objects[id]; filter(obj) {
+			return obj, true
+		}
+	}
+	return nil, false
+}
+
+// newID returns a random identifier with the given prefix.
+func newID(prefix string, length int) string {
+	buf := make([]byte, length/2)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return prefix + hex.EncodeToString(buf)
+}
+
+// cloneObject returns a deep copy of obj, so that callers cannot mutate stored entities.
+func cloneObject(obj Object) Object {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var out Object
+	if err := json.Unmarshal(data, &out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// mergePatch applies patch to obj the way the Management API applies PATCH bodies:
+// top-level keys are replaced and null values remove the key.
+func mergePatch(obj, patch Object) {
+	for k, v := range patch {
+		if v == nil {
+			delete(obj, k)
+			continue
+		}
+		obj[k] = v
+	}
+}
+
+// project returns obj restricted to (or without) the given comma-separated fields.
+func project(obj Object, fields string, include bool) Object {
+	if fields == "" {
+		return obj
+	}
+	set := map[string]bool{}
+	for _, f := range strings.Split(fields, ",") {
+		set[strings.TrimSpace(f)] = true
+	}
+	out := Object{}
+	for k, v := range obj {
+		if set[k] == include {
+			out[k] = v
+		}
+	}
+	return out
+}
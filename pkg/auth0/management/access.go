@@ -0,0 +1,198 @@
+// Package management provides an authenticated client for the Auth0 Management API of an A0Tenant.
+package management
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// Keys of the tenant credentials in the Secret referenced by TenantAuth.SecretRef
+const (
+	ClientIDKey     = "clientId"
+	ClientSecretKey = "clientSecret"
+)
+
+// tokenLifetimeRatio is the fraction of the token lifetime after which a new token is requested
+const tokenLifetimeRatio = 0.9
+
+// Credentials are the client credentials used to obtain Management API tokens for a tenant
+type Credentials struct {
+	Domain       string
+	ClientID     string
+	ClientSecret string
+}
+
+// LoadCredentials reads the domain and client credentials of tenant from the Secret referenced by its TenantAuth.
+// The Secret is looked up in the namespace of the tenant when the reference has no namespace.
+func LoadCredentials(ctx context.Context, kube client.Reader, tenant *v1.A0Tenant) (*Credentials, error) {
+	auth := tenant.Spec.Auth
+	if auth == nil || auth.Domain == nil || *auth.Domain == "" {
+		return nil, fmt.Errorf("tenant %s/%s has no authentication domain", tenant.Namespace, tenant.Name)
+	}
+	if auth.SecretRef == nil {
+		return nil, fmt.Errorf("tenant %s/%s has no authentication secret", tenant.Namespace, tenant.Name)
+	}
+	if auth.SecretRef.Name == "" {
+		return nil, fmt.Errorf("tenant %s/%s has no secret name", tenant.Namespace, tenant.Name)
+	}
+
+	namespace := auth.SecretRef.Namespace
+	if namespace == "" {
+		namespace = tenant.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: auth.SecretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("tenant %s/%s has missing secret: %w", tenant.Namespace, tenant.Name, err)
+	}
+
+	clientID, ok := secret.Data[ClientIDKey]
+	if !ok {
+		return nil, fmt.Errorf("tenant %s/%s has missing %s value on secret", tenant.Namespace, tenant.Name, ClientIDKey)
+	}
+	clientSecret, ok := secret.Data[ClientSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("tenant %s/%s has missing %s value on secret", tenant.Namespace, tenant.Name, ClientSecretKey)
+	}
+
+	return &Credentials{
+		Domain:       *auth.Domain,
+		ClientID:     string(clientID),
+		ClientSecret: string(clientSecret),
+	}, nil
+}
+
+// TenantApiAccess obtains and caches Management API access tokens for a single tenant.
+// It is safe for concurrent use; callers should ask for the token before every request rather than keeping it.
+type TenantApiAccess struct {
+	credentials Credentials
+	httpClient  *http.Client
+	now         func() time.Time
+
+	mu              sync.Mutex
+	accessToken     string
+	tokenExpiration time.Time
+}
+
+// AccessOption configures a TenantApiAccess
+type AccessOption func(*TenantApiAccess)
+
+// WithHTTPClient sets the HTTP client used to request tokens
+func WithHTTPClient(c *http.Client) AccessOption {
+	return func(a *TenantApiAccess) {
+		a.httpClient = c
+	}
+}
+
+// WithClock sets the function used to tell the current time
+func WithClock(now func() time.Time) AccessOption {
+	return func(a *TenantApiAccess) {
+		a.now = now
+	}
+}
+
+// NewTenantApiAccess returns a TenantApiAccess for the given credentials
+func NewTenantApiAccess(credentials Credentials, opts ...AccessOption) *TenantApiAccess {
+	a := &TenantApiAccess{
+		credentials: credentials,
+		httpClient:  http.DefaultClient,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Domain returns the tenant domain
+func (a *TenantApiAccess) Domain() string {
+	return a.credentials.Domain
+}
+
+// BaseURL returns the base URL of the Management API of the tenant
+func (a *TenantApiAccess) BaseURL() string {
+	return "https://" + a.credentials.Domain + "/api/v2/"
+}
+
+// AccessToken returns a valid access token, requesting a new one once the cached token
+// has reached 90% of its lifetime.
+func (a *TenantApiAccess) AccessToken(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken != "" && a.now().Before(a.tokenExpiration) {
+		return a.accessToken, nil
+	}
+
+	token, expiresIn, err := a.requestToken(ctx)
+	if err != nil {
+		a.accessToken = ""
+		return "", fmt.Errorf("tenant %s failed to retrieve management API token: %w", a.credentials.Domain, err)
+	}
+
+	a.accessToken = token
+	a.tokenExpiration = a.now().Add(time.Duration(float64(expiresIn) * tokenLifetimeRatio * float64(time.Second)))
+	return token, nil
+}
+
+// InvalidateAccessToken evicts the cached access token, so that the next call to AccessToken requests a new one.
+// Call it after the Management API rejects a token with 401 Unauthorized.
+func (a *TenantApiAccess) InvalidateAccessToken() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.accessToken = ""
+	a.tokenExpiration = time.Time{}
+}
+
+// requestToken performs the client credentials grant against the tenant.
+func (a *TenantApiAccess) requestToken(ctx context.Context) (string, int64, error) {
+	body, err := json.Marshal(map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     a.credentials.ClientID,
+		"client_secret": a.credentials.ClientSecret,
+		"audience":      a.BaseURL(),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+a.credentials.Domain+"/oauth/token", bytes.NewReader(body))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", 0, fmt.Errorf("failed to decode token response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("token request failed with status %d: %s: %s", resp.StatusCode, out.Error, out.ErrorDescription)
+	}
+	if out.AccessToken == "" {
+		return "", 0, fmt.Errorf("token response has no access token")
+	}
+	return out.AccessToken, out.ExpiresIn, nil
+}
@@ -0,0 +1,62 @@
+package management
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// Cache hands out one Client per A0Tenant, so that access tokens are shared by everything
+// acting on the same tenant in the process. It is safe for concurrent use.
+//
+// The Secrets are read with the kube reader given to NewCache, which is expected to be served from the informer
+// cache of the manager, so that reading them for every client is cheap.
+type Cache struct {
+	kube       client.Reader
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// NewCache returns a Cache that reads tenant credentials with kube and sends requests with httpClient.
+// A nil httpClient uses http.DefaultClient.
+func NewCache(kube client.Reader, httpClient *http.Client) *Cache {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Cache{kube: kube, httpClient: httpClient, clients: map[string]*Client{}}
+}
+
+// ClientFor returns the Client of tenant. The credentials are read from the Secret of the tenant on every call, and
+// the cached client is replaced when they have changed, e.g. after the Secret was rotated or the domain was edited.
+func (c *Cache) ClientFor(ctx context.Context, tenant *v1.A0Tenant) (*Client, error) {
+	key := tenant.Namespace + "/" + tenant.Name
+
+	credentials, err := LoadCredentials(ctx, c.kube, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.clients[key]; ok && existing.access.credentials == *credentials {
+		return existing, nil
+	}
+
+	created := NewClient(NewTenantApiAccess(*credentials, WithHTTPClient(c.httpClient)), c.httpClient)
+	c.clients[key] = created
+	return created, nil
+}
+
+// Forget evicts the client of tenant, e.g. after the tenant was deleted
+func (c *Cache) Forget(tenant *v1.A0Tenant) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.clients, tenant.Namespace+"/"+tenant.Name)
+}
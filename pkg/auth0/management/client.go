@@ -0,0 +1,386 @@
+package management
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// Object is an Auth0 entity as it is represented on the wire
+type Object = map[string]interface{}
+
+// PerPage is the page size used when listing all entities of a collection, the maximum allowed by Auth0
+const PerPage = 100
+
+// Error is an error returned by the Management API
+type Error struct {
+	StatusCode int    `json:"statusCode"`
+	Err        string `json:"error"`
+	Message    string `json:"message"`
+	ErrorCode  string `json:"errorCode"`
+
+	// Header holds the response headers, including the x-ratelimit-* headers
+	Header http.Header `json:"-"`
+}
+
+func (e *Error) Error() string {
+	if e.ErrorCode != "" {
+		return fmt.Sprintf("auth0: %d %s: %s (%s)", e.StatusCode, e.Err, e.Message, e.ErrorCode)
+	}
+	return fmt.Sprintf("auth0: %d %s: %s", e.StatusCode, e.Err, e.Message)
+}
+
+// StatusCodeOf returns the HTTP status code of a Management API error, or 0 if err is not one
+func StatusCodeOf(err error) int {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound returns true if err is a 404 Not Found from the Management API
+func IsNotFound(err error) bool {
+	return StatusCodeOf(err) == http.StatusNotFound
+}
+
+// IsConflict returns true if err is a 409 Conflict from the Management API
+func IsConflict(err error) bool {
+	return StatusCodeOf(err) == http.StatusConflict
+}
+
+// IsRateLimited returns true if err is a 429 Too Many Requests from the Management API
+func IsRateLimited(err error) bool {
+	return StatusCodeOf(err) == http.StatusTooManyRequests
+}
+
+// Client is an authenticated Management API client for a single tenant. It is safe for concurrent use.
+type Client struct {
+	access     *TenantApiAccess
+	httpClient *http.Client
+}
+
+// NewClient returns a Client that authenticates with access. A nil httpClient uses http.DefaultClient.
+func NewClient(access *TenantApiAccess, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{access: access, httpClient: httpClient}
+}
+
+// Access returns the token access of the client
+func (c *Client) Access() *TenantApiAccess {
+	return c.access
+}
+
+// Do sends a request to the Management API and decodes the JSON response into out, if not nil.
+// path is relative to the API base URL, e.g. "clients/abc". When the token is rejected with
+// 401 Unauthorized it is invalidated and the request is retried once with a new token.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	resp, err := c.send(ctx, method, path, query, payload)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		drain(resp)
+		c.access.InvalidateAccessToken()
+		resp, err = c.send(ctx, method, path, query, payload)
+	}
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode, Err: http.StatusText(resp.StatusCode), Header: resp.Header}
+		_ = json.NewDecoder(resp.Body).Decode(apiErr)
+		apiErr.StatusCode = resp.StatusCode
+		return apiErr
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, payload []byte) (*http.Response, error) {
+	token, err := c.access.AccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.access.BaseURL() + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return c.httpClient.Do(req)
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// ListAll returns every entity of a paged collection, requesting pages of PerPage entities with
+// include_totals until the reported total is reached, the same way as Auth0PaginationHelper.
+// key is the property of the paged response holding the entities, e.g. "client_grants".
+func (c *Client) ListAll(ctx context.Context, path, key string, query url.Values) ([]Object, error) {
+	var all []Object
+	for page := 0; ; page++ {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("page", strconv.Itoa(page))
+		q.Set("per_page", strconv.Itoa(PerPage))
+		q.Set("include_totals", "true")
+
+		var resp map[string]json.RawMessage
+		if err := c.Do(ctx, http.MethodGet, path, q, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		var paging struct {
+			Start  int `json:"start"`
+			Length int `json:"length"`
+			Total  int `json:"total"`
+		}
+		var items []Object
+		for field, dst := range map[string]interface{}{"start": &paging.Start, "length": &paging.Length, "total": &paging.Total, key: &items} {
+			if raw, ok := resp[field]; ok {
+				if err := json.Unmarshal(raw, dst); err != nil {
+					return nil, fmt.Errorf("failed to decode %s of %s page %d: %w", field, path, page, err)
+				}
+			}
+		}
+
+		all = append(all, items...)
+		if len(items) == 0 || paging.Start+paging.Length >= paging.Total {
+			return all, nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context, path, id string) (Object, error) {
+	var out Object
+	if err := c.Do(ctx, http.MethodGet, path+"/"+url.PathEscape(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) create(ctx context.Context, path string, obj Object) (Object, error) {
+	var out Object
+	if err := c.Do(ctx, http.MethodPost, path, nil, obj, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) update(ctx context.Context, path, id string, obj Object) (Object, error) {
+	var out Object
+	if err := c.Do(ctx, http.MethodPatch, path+"/"+url.PathEscape(id), nil, obj, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) delete(ctx context.Context, path, id string) error {
+	return c.Do(ctx, http.MethodDelete, path+"/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// GetClient returns the client with the given client ID
+func (c *Client) GetClient(ctx context.Context, id string) (Object, error) {
+	return c.get(ctx, "clients", id)
+}
+
+// ListClients returns all clients matching query
+func (c *Client) ListClients(ctx context.Context, query url.Values) ([]Object, error) {
+	return c.ListAll(ctx, "clients", "clients", query)
+}
+
+// HasMatchingCallbackUrls reports whether the callbacks of client contain all of the callback URLs of find, or any
+// of them when its match mode is loose. URLs are compared case-insensitively.
+func HasMatchingCallbackUrls(client Object, find *v1.ClientFind) bool {
+	callbacks, _ := client["callbacks"].([]interface{})
+	contains := func(u string) bool {
+		for _, cb := range callbacks {
+			if s, _ := cb.(string); strings.EqualFold(s, u) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(callbacks) == 0 || len(find.CallbackUrls) == 0 {
+		return false
+	}
+	strict := find.CallbackUrlMatchMode == nil || *find.CallbackUrlMatchMode != "loose"
+	for _, u := range find.CallbackUrls {
+		switch {
+		case strict && !contains(u):
+			return false
+		case !strict && contains(u):
+			return true
+		}
+	}
+	return strict
+}
+
+// CreateClient creates a client and returns it, including its generated client_id and client_secret
+func (c *Client) CreateClient(ctx context.Context, obj Object) (Object, error) {
+	return c.create(ctx, "clients", obj)
+}
+
+// UpdateClient updates the client with the given client ID
+func (c *Client) UpdateClient(ctx context.Context, id string, obj Object) (Object, error) {
+	return c.update(ctx, "clients", id, obj)
+}
+
+// DeleteClient deletes the client with the given client ID
+func (c *Client) DeleteClient(ctx context.Context, id string) error {
+	return c.delete(ctx, "clients", id)
+}
+
+// ListClientConnections returns the connections enabled for the client with the given client ID. The endpoint
+// pages with a checkpoint instead of page numbers, which is followed until no next checkpoint is returned.
+func (c *Client) ListClientConnections(ctx context.Context, id string) ([]Object, error) {
+	var all []Object
+	q := url.Values{"take": {strconv.Itoa(PerPage)}}
+	for {
+		var resp struct {
+			Connections []Object `json:"connections"`
+			Next        string   `json:"next"`
+		}
+		if err := c.Do(ctx, http.MethodGet, "clients/"+url.PathEscape(id)+"/connections", q, nil, &resp); err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Connections...)
+		if resp.Next == "" || len(resp.Connections) == 0 {
+			return all, nil
+		}
+		q.Set("from", resp.Next)
+	}
+}
+
+// UpdateConnectionClients enables or disables clients for the connection with the given ID. Each change is an
+// object with a client_id and a boolean status.
+func (c *Client) UpdateConnectionClients(ctx context.Context, id string, changes []Object) error {
+	return c.Do(ctx, http.MethodPatch, "connections/"+url.PathEscape(id)+"/clients", nil, changes, nil)
+}
+
+// GetConnection returns the connection with the given ID
+func (c *Client) GetConnection(ctx context.Context, id string) (Object, error) {
+	return c.get(ctx, "connections", id)
+}
+
+// ListConnections returns all connections matching query, e.g. filtered by strategy or name
+func (c *Client) ListConnections(ctx context.Context, query url.Values) ([]Object, error) {
+	return c.ListAll(ctx, "connections", "connections", query)
+}
+
+// CreateConnection creates a connection and returns it
+func (c *Client) CreateConnection(ctx context.Context, obj Object) (Object, error) {
+	return c.create(ctx, "connections", obj)
+}
+
+// UpdateConnection updates the connection with the given ID
+func (c *Client) UpdateConnection(ctx context.Context, id string, obj Object) (Object, error) {
+	return c.update(ctx, "connections", id, obj)
+}
+
+// DeleteConnection deletes the connection with the given ID
+func (c *Client) DeleteConnection(ctx context.Context, id string) error {
+	return c.delete(ctx, "connections", id)
+}
+
+// ListClientGrants returns all client grants matching query, e.g. filtered by client_id or audience
+func (c *Client) ListClientGrants(ctx context.Context, query url.Values) ([]Object, error) {
+	return c.ListAll(ctx, "client-grants", "client_grants", query)
+}
+
+// CreateClientGrant creates a client grant and returns it
+func (c *Client) CreateClientGrant(ctx context.Context, obj Object) (Object, error) {
+	return c.create(ctx, "client-grants", obj)
+}
+
+// UpdateClientGrant updates the client grant with the given ID
+func (c *Client) UpdateClientGrant(ctx context.Context, id string, obj Object) (Object, error) {
+	return c.update(ctx, "client-grants", id, obj)
+}
+
+// DeleteClientGrant deletes the client grant with the given ID
+func (c *Client) DeleteClientGrant(ctx context.Context, id string) error {
+	return c.delete(ctx, "client-grants", id)
+}
+
+// GetResourceServer returns the resource server with the given ID or identifier
+func (c *Client) GetResourceServer(ctx context.Context, id string) (Object, error) {
+	return c.get(ctx, "resource-servers", id)
+}
+
+// ListResourceServers returns all resource servers matching query
+func (c *Client) ListResourceServers(ctx context.Context, query url.Values) ([]Object, error) {
+	return c.ListAll(ctx, "resource-servers", "resource_servers", query)
+}
+
+// CreateResourceServer creates a resource server and returns it
+func (c *Client) CreateResourceServer(ctx context.Context, obj Object) (Object, error) {
+	return c.create(ctx, "resource-servers", obj)
+}
+
+// UpdateResourceServer updates the resource server with the given ID
+func (c *Client) UpdateResourceServer(ctx context.Context, id string, obj Object) (Object, error) {
+	return c.update(ctx, "resource-servers", id, obj)
+}
+
+// DeleteResourceServer deletes the resource server with the given ID
+func (c *Client) DeleteResourceServer(ctx context.Context, id string) error {
+	return c.delete(ctx, "resource-servers", id)
+}
+
+// GetTenantSettings returns the settings of the tenant
+func (c *Client) GetTenantSettings(ctx context.Context) (Object, error) {
+	var out Object
+	if err := c.Do(ctx, http.MethodGet, "tenants/settings", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateTenantSettings updates the settings of the tenant
+func (c *Client) UpdateTenantSettings(ctx context.Context, obj Object) (Object, error) {
+	var out Object
+	if err := c.Do(ctx, http.MethodPatch, "tenants/settings", nil, obj, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,254 @@
+package management_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+func newServer(t *testing.T, opts ...fake.Option) *fake.Server {
+	t.Helper()
+	s := fake.NewUnstartedServer(opts...)
+	s.StartTLS()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newClient(s *fake.Server, opts ...management.AccessOption) *management.Client {
+	opts = append([]management.AccessOption{management.WithHTTPClient(s.Client())}, opts...)
+	access := management.NewTenantApiAccess(management.Credentials{
+		Domain:       s.Domain(),
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+	}, opts...)
+	return management.NewClient(access, s.Client())
+}
+
+func TestTokenIsCachedUntilNinetyPercentOfLifetime(t *testing.T) {
+	s := newServer(t, fake.WithTokenLifetime(100*time.Second))
+
+	now := time.Now()
+	var mu sync.Mutex
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	c := newClient(s, management.WithClock(clock))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetTenantSettings(ctx); err != nil {
+			t.Fatalf("GetTenantSettings failed: %v", err)
+		}
+	}
+	if n := s.TokenRequests(); n != 1 {
+		t.Fatalf("expected 1 token request, got %d", n)
+	}
+
+	mu.Lock()
+	now = now.Add(89 * time.Second)
+	mu.Unlock()
+	if _, err := c.GetTenantSettings(ctx); err != nil {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+	if n := s.TokenRequests(); n != 1 {
+		t.Fatalf("expected token to be reused before 90%% of its lifetime, got %d requests", n)
+	}
+
+	mu.Lock()
+	now = now.Add(2 * time.Second)
+	mu.Unlock()
+	if _, err := c.GetTenantSettings(ctx); err != nil {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+	if n := s.TokenRequests(); n != 2 {
+		t.Fatalf("expected token to be refreshed after 90%% of its lifetime, got %d requests", n)
+	}
+}
+
+func TestTokenIsInvalidatedOnUnauthorized(t *testing.T) {
+	s := newServer(t)
+	c := newClient(s)
+	ctx := context.Background()
+
+	if _, err := c.GetTenantSettings(ctx); err != nil {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+	s.RevokeTokens()
+	if _, err := c.GetTenantSettings(ctx); err != nil {
+		t.Fatalf("expected request to succeed with a new token, got %v", err)
+	}
+	if n := s.TokenRequests(); n != 2 {
+		t.Fatalf("expected 2 token requests, got %d", n)
+	}
+}
+
+func TestConcurrentRequestsShareToken(t *testing.T) {
+	s := newServer(t)
+	c := newClient(s)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetTenantSettings(context.Background()); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+	if n := s.TokenRequests(); n != 1 {
+		t.Fatalf("expected 1 token request, got %d", n)
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	s := newServer(t)
+	for i := 0; i < 230; i++ {
+		s.Seed(fake.Clients, fake.Object{"name": fmt.Sprintf("app-%d", i)})
+	}
+
+	clients, err := newClient(s).ListClients(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListClients failed: %v", err)
+	}
+	if len(clients) != 230 {
+		t.Fatalf("expected 230 clients, got %d", len(clients))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	s := newServer(t)
+	c := newClient(s)
+	ctx := context.Background()
+
+	if _, err := c.GetConnection(ctx, "con_missing"); !management.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := c.CreateResourceServer(ctx, management.Object{"identifier": "https://api.example.com"}); err != nil {
+		t.Fatalf("CreateResourceServer failed: %v", err)
+	}
+	if _, err := c.CreateResourceServer(ctx, management.Object{"identifier": "https://api.example.com"}); !management.IsConflict(err) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+
+	s.InjectRateLimits(1)
+	_, err := c.GetTenantSettings(ctx)
+	if !management.IsRateLimited(err) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+}
+
+func TestCacheLoadsCredentialsFromSecret(t *testing.T) {
+	s := newServer(t)
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	kube := ctrlfake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "auth0", Namespace: "default"},
+		Data: map[string][]byte{
+			management.ClientIDKey:     []byte(s.ClientID),
+			management.ClientSecretKey: []byte(s.ClientSecret),
+		},
+	}).Build()
+
+	domain := s.Domain()
+	tenant := &v1.A0Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "default"},
+		Spec: v1.A0TenantSpec{Auth: &v1.TenantAuth{
+			Domain:    &domain,
+			SecretRef: &v1.V1SecretReference{Name: "auth0"},
+		}},
+	}
+
+	cache := management.NewCache(kube, s.Client())
+	c, err := cache.ClientFor(context.Background(), tenant)
+	if err != nil {
+		t.Fatalf("ClientFor failed: %v", err)
+	}
+	if again, _ := cache.ClientFor(context.Background(), tenant); again != c {
+		t.Fatalf("expected the cached client to be reused")
+	}
+	if _, err := c.GetTenantSettings(context.Background()); err != nil {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+
+	tenant.Spec.Auth.SecretRef.Name = "missing"
+	cache.Forget(tenant)
+	if _, err := cache.ClientFor(context.Background(), tenant); err == nil {
+		t.Fatalf("expected an error for a missing secret")
+	}
+}
+
+func TestCachePicksUpRotatedCredentials(t *testing.T) {
+	s := newServer(t, fake.WithCredentials("rotated-client", "rotated-secret"))
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "auth0", Namespace: "default"},
+		Data: map[string][]byte{
+			management.ClientIDKey:     []byte("old-client"),
+			management.ClientSecretKey: []byte("old-secret"),
+		},
+	}
+	kube := ctrlfake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	domain := s.Domain()
+	tenant := &v1.A0Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "default"},
+		Spec: v1.A0TenantSpec{Auth: &v1.TenantAuth{
+			Domain:    &domain,
+			SecretRef: &v1.V1SecretReference{Name: "auth0"},
+		}},
+	}
+
+	ctx := context.Background()
+	cache := management.NewCache(kube, s.Client())
+	stale, err := cache.ClientFor(ctx, tenant)
+	if err != nil {
+		t.Fatalf("ClientFor failed: %v", err)
+	}
+	if _, err := stale.GetTenantSettings(ctx); err == nil {
+		t.Fatalf("expected the old credentials to be rejected")
+	}
+
+	secret.Data[management.ClientIDKey] = []byte("rotated-client")
+	secret.Data[management.ClientSecretKey] = []byte("rotated-secret")
+	if err := kube.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := cache.ClientFor(ctx, tenant)
+	if err != nil {
+		t.Fatalf("ClientFor failed: %v", err)
+	}
+	if rotated == stale {
+		t.Fatalf("expected a new client after the secret was rotated")
+	}
+	if _, err := rotated.GetTenantSettings(ctx); err != nil {
+		t.Fatalf("GetTenantSettings failed with the rotated credentials: %v", err)
+	}
+	if again, _ := cache.ClientFor(ctx, tenant); again != rotated {
+		t.Fatalf("expected the client to be reused while the credentials are unchanged")
+	}
+}
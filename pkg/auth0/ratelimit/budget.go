@@ -0,0 +1,204 @@
+// Package ratelimit keeps Auth0 Management API calls within the rate limits of each tenant.
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Default budget of a tenant, conservative enough for the Management API limits of every Auth0 plan
+const (
+	DefaultRate  = 10.0
+	DefaultBurst = 20
+)
+
+// Headers returned by the Management API describing the rate limit of the tenant
+const (
+	HeaderLimit     = "X-RateLimit-Limit"
+	HeaderRemaining = "X-RateLimit-Remaining"
+	HeaderReset     = "X-RateLimit-Reset"
+	HeaderRetry     = "Retry-After"
+)
+
+// State is a snapshot of the budget of a tenant
+type State struct {
+	// Limit, Remaining and Reset are the values of the last x-ratelimit-* headers received
+	Limit     int
+	Remaining int
+	Reset     time.Time
+
+	// Tokens is the number of requests that may currently be sent without waiting
+	Tokens float64
+}
+
+// Budget is a token bucket shared by every request sent to a single tenant domain.
+// The bucket refills at a fixed rate and is drained further by the remaining budget the server reports.
+type Budget struct {
+	domain string
+	rate   float64
+	burst  float64
+	now    func() time.Time
+
+	mu        sync.Mutex
+	tokens    float64
+	last      time.Time
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func newBudget(domain string, rate float64, burst int, now func() time.Time) *Budget {
+	return &Budget{
+		domain:    domain,
+		rate:      rate,
+		burst:     float64(burst),
+		now:       now,
+		tokens:    float64(burst),
+		last:      now(),
+		remaining: -1,
+	}
+}
+
+// Domain returns the tenant domain of the budget
+func (b *Budget) Domain() string {
+	return b.domain
+}
+
+// State returns a snapshot of the budget
+func (b *Budget) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(b.now())
+	return State{Limit: b.limit, Remaining: b.remaining, Reset: b.reset, Tokens: b.tokens}
+}
+
+// Wait blocks until a request may be sent to the tenant or ctx is done.
+func (b *Budget) Wait(ctx context.Context) error {
+	for {
+		delay := b.take()
+		if delay <= 0 {
+			return nil
+		}
+
+		metrics.waitSeconds.WithLabelValues(b.domain).Add(delay.Seconds())
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// take consumes a token if one is available, otherwise it returns how long to wait before trying again.
+func (b *Budget) take() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.refill(now)
+
+	// the server reported an exhausted budget; nothing may be sent before it resets
+	if b.remaining == 0 && now.Before(b.reset) {
+		return b.reset.Sub(now)
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		metrics.budgetTokens.WithLabelValues(b.domain).Set(b.tokens)
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// refill adds the tokens accumulated since the last refill. The caller must hold b.mu.
+func (b *Budget) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+// Observe updates the budget from the x-ratelimit-* headers of a response
+func (b *Budget) Observe(h http.Header) {
+	limit, hasLimit := headerInt(h, HeaderLimit)
+	remaining, hasRemaining := headerInt(h, HeaderRemaining)
+	reset, hasReset := headerInt(h, HeaderReset)
+	if !hasLimit && !hasRemaining && !hasReset {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(b.now())
+	if hasLimit {
+		b.limit = limit
+		metrics.limit.WithLabelValues(b.domain).Set(float64(limit))
+	}
+	if hasReset {
+		b.reset = time.Unix(int64(reset), 0)
+		metrics.reset.WithLabelValues(b.domain).Set(float64(reset))
+	}
+	if hasRemaining {
+		b.remaining = remaining
+		metrics.remaining.WithLabelValues(b.domain).Set(float64(remaining))
+
+		// never assume more budget than the server has left
+		if float64(remaining) < b.tokens {
+			b.tokens = float64(remaining)
+		}
+	}
+	metrics.budgetTokens.WithLabelValues(b.domain).Set(b.tokens)
+}
+
+func headerInt(h http.Header, key string) (int, bool) {
+	v := h.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// Budgets holds the budget of every tenant domain. It is safe for concurrent use.
+type Budgets struct {
+	rate  float64
+	burst int
+	now   func() time.Time
+
+	mu      sync.Mutex
+	budgets map[string]*Budget
+}
+
+// DefaultBudgets is shared by every Transport that has no Budgets of its own, so that all
+// Management API clients in the process draw from the same budget per tenant.
+var DefaultBudgets = NewBudgets(DefaultRate, DefaultBurst)
+
+// NewBudgets returns Budgets that allow rate requests per second with bursts of up to burst requests per domain
+func NewBudgets(rate float64, burst int) *Budgets {
+	return &Budgets{rate: rate, burst: burst, now: time.Now, budgets: map[string]*Budget{}}
+}
+
+// Get returns the budget of domain, creating it on first use
+func (b *Budgets) Get(domain string) *Budget {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	budget, ok := b.budgets[domain]
+	if !ok {
+		budget = newBudget(domain, b.rate, b.burst, b.now)
+		b.budgets[domain] = budget
+	}
+	return budget
+}
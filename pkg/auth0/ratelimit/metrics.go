@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var metrics = struct {
+	limit        *prometheus.GaugeVec
+	remaining    *prometheus.GaugeVec
+	reset        *prometheus.GaugeVec
+	budgetTokens *prometheus.GaugeVec
+	waitSeconds  *prometheus.CounterVec
+	throttled    *prometheus.CounterVec
+	retries      *prometheus.CounterVec
+}{
+	limit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auth0_ratelimit_limit",
+		Help: "Management API rate limit of the tenant, from the last x-ratelimit-limit header.",
+	}, []string{"domain"}),
+	remaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auth0_ratelimit_remaining",
+		Help: "Management API requests remaining in the current window, from the last x-ratelimit-remaining header.",
+	}, []string{"domain"}),
+	reset: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auth0_ratelimit_reset_timestamp_seconds",
+		Help: "Unix time at which the Management API rate limit window resets, from the last x-ratelimit-reset header.",
+	}, []string{"domain"}),
+	budgetTokens: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auth0_ratelimit_budget_tokens",
+		Help: "Requests that may be sent to the tenant without waiting for the local budget.",
+	}, []string{"domain"}),
+	waitSeconds: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth0_ratelimit_wait_seconds_total",
+		Help: "Time spent waiting for the local budget of the tenant.",
+	}, []string{"domain"}),
+	throttled: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth0_ratelimit_throttled_total",
+		Help: "Management API responses with status 429 Too Many Requests.",
+	}, []string{"domain"}),
+	retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth0_ratelimit_retries_total",
+		Help: "Management API requests retried after a 429 Too Many Requests.",
+	}, []string{"domain"}),
+}
+
+// Collectors returns the Prometheus collectors of the package, for registries other than the controller-runtime one
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		metrics.limit,
+		metrics.remaining,
+		metrics.reset,
+		metrics.budgetTokens,
+		metrics.waitSeconds,
+		metrics.throttled,
+		metrics.retries,
+	}
+}
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(Collectors()...)
+}
@@ -0,0 +1,146 @@
+package ratelimit
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default retry settings of a Transport
+const (
+	DefaultMaxRetries = 3
+	DefaultBackoff    = 500 * time.Millisecond
+	DefaultMaxDelay   = 30 * time.Second
+)
+
+// Transport is an http.RoundTripper that keeps requests to the Management API within the budget of the
+// tenant and retries requests rejected with 429 Too Many Requests.
+//
+// Requests are throttled by the Budget of their host, which also tracks the x-ratelimit-* headers of
+// every response. A 429 is retried after the delay given by Retry-After or x-ratelimit-reset, falling
+// back to exponential backoff, with up to 20% of upward jitter. When the delay exceeds MaxDelay or the
+// retries are exhausted the 429 response is returned, so that callers can requeue instead of blocking.
+type Transport struct {
+	// Base is the transport used to send requests. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	// Budgets holds the per-domain budgets. If nil, DefaultBudgets is used.
+	Budgets *Budgets
+
+	// MaxRetries is the number of times a request is retried after a 429
+	MaxRetries int
+
+	// Backoff is the delay before the first retry when the response has no delay hint; it doubles on every retry
+	Backoff time.Duration
+
+	// MaxDelay is the longest delay the transport waits before a retry
+	MaxDelay time.Duration
+
+	// jitter returns a value in [0, 1) used to spread retries
+	jitter func() float64
+}
+
+// NewTransport returns a Transport sending requests with base and default retry settings
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{
+		Base:       base,
+		MaxRetries: DefaultMaxRetries,
+		Backoff:    DefaultBackoff,
+		MaxDelay:   DefaultMaxDelay,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	budget := t.budgets().Get(req.URL.Host)
+	metered := strings.HasPrefix(req.URL.Path, "/api/v2/")
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 {
+			r = req.Clone(req.Context())
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		if metered {
+			if err := budget.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.base().RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		if metered {
+			budget.Observe(resp.Header)
+		}
+		if resp.StatusCode != http.StatusTooManyRequests {
+			return resp, nil
+		}
+
+		metrics.throttled.WithLabelValues(budget.Domain()).Inc()
+		if attempt >= t.MaxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		delay := t.retryDelay(resp.Header, attempt)
+		if delay > t.MaxDelay {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		metrics.retries.WithLabelValues(budget.Domain()).Inc()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns how long to wait before retrying a request rejected with 429.
+func (t *Transport) retryDelay(h http.Header, attempt int) time.Duration {
+	var delay time.Duration
+	if v, err := strconv.Atoi(h.Get(HeaderRetry)); err == nil && v > 0 {
+		delay = time.Duration(v) * time.Second
+	} else if v, err := strconv.ParseInt(h.Get(HeaderReset), 10, 64); err == nil {
+		delay = time.Until(time.Unix(v, 0))
+	}
+	if delay <= 0 {
+		delay = t.Backoff << attempt
+	}
+
+	// jitter only spreads upward so that retries never happen before the reset hint
+	jitter := rand.Float64
+	if t.jitter != nil {
+		jitter = t.jitter
+	}
+	return time.Duration(float64(delay) * (1 + jitter()*0.2))
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) budgets() *Budgets {
+	if t.Budgets != nil {
+		return t.Budgets
+	}
+	return DefaultBudgets
+}
@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+func newClient(t *testing.T, s *fake.Server, transport *Transport) *management.Client {
+	t.Helper()
+	transport.Base = s.Client().Transport
+	httpClient := &http.Client{Transport: transport}
+	access := management.NewTenantApiAccess(management.Credentials{
+		Domain:       s.Domain(),
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+	}, management.WithHTTPClient(httpClient))
+	return management.NewClient(access, httpClient)
+}
+
+func newServer(t *testing.T) *fake.Server {
+	t.Helper()
+	s := fake.NewUnstartedServer()
+	s.StartTLS()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestRetriesRateLimitedRequests(t *testing.T) {
+	s := newServer(t)
+	transport := NewTransport(nil)
+	transport.Budgets = NewBudgets(1000, 1000)
+	transport.jitter = func() float64 { return 0 }
+	c := newClient(t, s, transport)
+
+	s.InjectRateLimits(1)
+	if _, err := c.GetTenantSettings(context.Background()); err != nil {
+		t.Fatalf("expected the request to be retried, got %v", err)
+	}
+
+	var statuses []int
+	for _, r := range s.Requests() {
+		if r.Path == "/api/v2/tenants/settings" {
+			statuses = append(statuses, r.Status)
+		}
+	}
+	if len(statuses) != 2 || statuses[0] != http.StatusTooManyRequests || statuses[1] != http.StatusOK {
+		t.Fatalf("expected a 429 followed by a 200, got %v", statuses)
+	}
+
+	state := transport.Budgets.Get(s.Domain()).State()
+	if state.Limit != fake.DefaultRateLimit || state.Remaining <= 0 {
+		t.Fatalf("expected the budget to track the rate limit headers, got %+v", state)
+	}
+}
+
+func TestReturnsRateLimitWhenRetriesAreExhausted(t *testing.T) {
+	s := newServer(t)
+	transport := NewTransport(nil)
+	transport.Budgets = NewBudgets(1000, 1000)
+	transport.MaxDelay = 0
+	c := newClient(t, s, transport)
+
+	s.InjectRateLimits(1)
+	if _, err := c.GetTenantSettings(context.Background()); !management.IsRateLimited(err) {
+		t.Fatalf("expected a rate limit error, got %v", err)
+	}
+}
+
+func TestBudgetRefillsAtRate(t *testing.T) {
+	now := time.Unix(1000, 0)
+	budgets := NewBudgets(2, 2)
+	budgets.now = func() time.Time { return now }
+	b := budgets.Get("tenant.auth0.com")
+
+	if b.take() != 0 || b.take() != 0 {
+		t.Fatalf("expected the burst to be available immediately")
+	}
+	if d := b.take(); d != 500*time.Millisecond {
+		t.Fatalf("expected to wait for the next token, got %v", d)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if d := b.take(); d != 0 {
+		t.Fatalf("expected a token after refilling, got %v", d)
+	}
+	if budgets.Get("tenant.auth0.com") != b {
+		t.Fatalf("expected the budget to be shared per domain")
+	}
+}
+
+func TestBudgetHonorsServerRemaining(t *testing.T) {
+	now := time.Unix(1000, 0)
+	budgets := NewBudgets(100, 100)
+	budgets.now = func() time.Time { return now }
+	b := budgets.Get("tenant.auth0.com")
+
+	h := http.Header{}
+	h.Set(HeaderLimit, "50")
+	h.Set(HeaderRemaining, "0")
+	h.Set(HeaderReset, strconv.FormatInt(now.Add(3*time.Second).Unix(), 10))
+	b.Observe(h)
+
+	if d := b.take(); d != 3*time.Second {
+		t.Fatalf("expected to wait for the server window to reset, got %v", d)
+	}
+
+	now = now.Add(3 * time.Second)
+	if d := b.take(); d != 0 {
+		t.Fatalf("expected a token after the reset, got %v", d)
+	}
+}
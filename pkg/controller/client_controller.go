@@ -0,0 +1,539 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+const (
+	// ClientFinalizer is the finalizer of A0Client, the same identifier the C# operator uses
+	ClientFinalizer = "kubernetes.auth0.com/v1clientfinalizer"
+
+	// ClientOwnerAnnotation marks a credentials Secret in another namespace than its A0Client, which cannot have an
+	// owner reference, as owned by the A0Client with the namespace/name in its value
+	ClientOwnerAnnotation = "kubernetes.auth0.com/owner"
+
+	// EnabledConnectionsIndex indexes A0Client objects by the namespace/name of the A0Connections they enable
+	EnabledConnectionsIndex = "spec.conf.enabledConnections"
+)
+
+// A0ClientReconciler reconciles A0Client objects with the clients of their tenant, and writes the client ID and
+// secret of each client into the Secret named by its secretRef.
+type A0ClientReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Clients provides the Management API client of each tenant
+	Clients ClientProvider
+
+	// Interval is how often clients are reconciled when nothing changes. Defaults to DefaultReconcileInterval.
+	Interval time.Duration
+
+	// Partition is the value of the v1.PartitionAnnotationKey annotation of the clients to reconcile. When empty,
+	// only clients without the annotation are reconciled.
+	Partition string
+}
+
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clients,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clients/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clients/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0connections,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0tenants,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile implements reconcile.Reconciler
+func (r *A0ClientReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	a0client := &v1.A0Client{}
+	if err := r.Get(ctx, req.NamespacedName, a0client); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !v1.InPartition(a0client, r.Partition) {
+		return ctrl.Result{}, nil
+	}
+
+	if !a0client.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, a0client)
+	}
+
+	patch := client.MergeFrom(a0client.DeepCopy())
+	if controllerutil.AddFinalizer(a0client, ClientFinalizer) {
+		if err := r.Patch(ctx, a0client, patch); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return finishReconcile(ctx, r.Client, a0client, r.reconcile(ctx, a0client), r.interval())
+}
+
+func (r *A0ClientReconciler) reconcile(ctx context.Context, a0client *v1.A0Client) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if a0client.Spec.Conf == nil {
+		return terminalError(v1.ReasonInvalidConfiguration, "A0Client %s/%s is missing configuration", a0client.Namespace, a0client.Name)
+	}
+
+	api, err := apiClientFor(ctx, r.Client, r.Clients, a0client)
+	if err != nil {
+		return err
+	}
+
+	mode := a0client.GetMode()
+	id := a0client.GetAuth0ID()
+	if id == "" {
+		if id, err = r.find(ctx, api, a0client); err != nil {
+			return err
+		}
+		if id == "" && mode == v1.ReconcileModePlan {
+			return r.planCreate(a0client)
+		}
+		if id == "" && mode == v1.ReconcileModeObserve {
+			return nil
+		}
+		if id == "" {
+			if id, err = r.create(ctx, api, a0client); err != nil {
+				return err
+			}
+			log.Info("created client", "id", id)
+		} else {
+			log.Info("found existing client", "id", id)
+		}
+
+		// persist the ID right away, so that a failure below cannot lead to a second client
+		a0client.SetAuth0ID(id)
+		if err := r.Status().Update(ctx, a0client); err != nil {
+			return err
+		}
+	}
+
+	live, err := api.GetClient(ctx, id)
+	if management.IsNotFound(err) {
+		a0client.SetAuth0ID("")
+		resetLastConf(a0client)
+		return missingEntityError("A0Client", a0client, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	drifted, err := driftedFields(a0client, a0client.Spec.Conf, live)
+	if err != nil {
+		return err
+	}
+	if mode != v1.ReconcileModeApply {
+		return r.observe(ctx, api, a0client, live, drifted)
+	}
+
+	if v1.HasPolicy(a0client, v1.PolicyTypeUpdate) {
+		// the live client has no enabled_connections, they are reconciled below
+		changed, err := drift.CompareIgnoring(a0client.Spec.Conf, live, unmanagedFields(a0client, drifted))
+		if err != nil {
+			return err
+		}
+		if len(changed) > 0 {
+			desired, err := drift.ToObject(a0client.Spec.Conf)
+			if err != nil {
+				return err
+			}
+			desired = withoutFields(desired, "client_id", "enabled_connections")
+			removeMissingMetadata(desired, live)
+			drift.Omit(desired, unmanagedFields(a0client, drifted))
+
+			log.Info("updating client", "id", id, "fields", differencePaths(changed))
+			if live, err = api.UpdateClient(ctx, id, desired); err != nil {
+				return err
+			}
+		}
+	}
+
+	if a0client.Spec.Conf.EnabledConnections != nil {
+		enabled, err := r.reconcileEnabledConnections(ctx, api, a0client, id)
+		if err != nil {
+			return err
+		}
+		live["enabled_connections"] = enabled
+	}
+
+	if err := r.applySecret(ctx, a0client, live); err != nil {
+		return err
+	}
+	return r.setLastConf(a0client, live, drifted)
+}
+
+// setLastConf records live in the status, see recordLastConf. The client secret is only kept in the referenced
+// Secret, never in the status.
+func (r *A0ClientReconciler) setLastConf(a0client *v1.A0Client, live management.Object, drifted []string) error {
+	return recordLastConf(a0client, withoutFields(live, "client_secret"), drifted)
+}
+
+// planCreate records the client that Apply mode would create.
+func (r *A0ClientReconciler) planCreate(a0client *v1.A0Client) error {
+	init, err := r.createConf(a0client)
+	if err != nil {
+		return err
+	}
+
+	conf := a0client.Spec.Conf.DeepCopy()
+	conf.EnabledConnections = nil
+	return planCreate(a0client, init, conf)
+}
+
+// observe records live without changing the client, and in Plan mode the changes that Apply mode would make.
+func (r *A0ClientReconciler) observe(ctx context.Context, api *management.Client, a0client *v1.A0Client, live management.Object, drifted []string) error {
+	if a0client.Spec.Conf.EnabledConnections != nil {
+		id, _ := live["client_id"].(string)
+		enabled, err := r.reconcileEnabledConnections(ctx, api, a0client, id)
+		if err != nil {
+			return err
+		}
+		live["enabled_connections"] = enabled
+	}
+
+	if a0client.GetMode() == v1.ReconcileModePlan {
+		// compare connection IDs, since the live client has no connection names
+		conf := a0client.Spec.Conf.DeepCopy()
+		for i, ref := range conf.EnabledConnections {
+			connectionID, err := resolveConnectionRefToID(ctx, r.Client, ref, a0client.Namespace)
+			if err != nil {
+				return err
+			}
+			conf.EnabledConnections[i] = v1.V1ConnectionReference{Id: &connectionID}
+		}
+		if err := planUpdate(a0client, conf, withoutFields(live, "client_secret"), unmanagedFields(a0client, drifted)); err != nil {
+			return err
+		}
+	}
+	return r.setLastConf(a0client, live, drifted)
+}
+
+// find returns the client ID of the client matching spec.find, or the name of the client when there is no
+// spec.find, or an empty string.
+func (r *A0ClientReconciler) find(ctx context.Context, api *management.Client, a0client *v1.A0Client) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if find := a0client.Spec.Find; find != nil {
+		if find.ClientId != nil && *find.ClientId != "" {
+			found, err := api.GetClient(ctx, *find.ClientId)
+			if management.IsNotFound(err) {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+			id, _ := found["client_id"].(string)
+			return id, nil
+		}
+
+		if len(find.CallbackUrls) > 0 {
+			list, err := api.ListClients(ctx, url.Values{"fields": {"client_id,name,callbacks"}})
+			if err != nil {
+				return "", err
+			}
+			var matches []string
+			for _, item := range list {
+				if management.HasMatchingCallbackUrls(item, find) {
+					id, _ := item["client_id"].(string)
+					matches = append(matches, id)
+				}
+			}
+			if len(matches) > 1 {
+				log.Info("found multiple clients matching callback URLs, using the first", "matches", matches)
+			}
+			if len(matches) > 0 {
+				return matches[0], nil
+			}
+		}
+		return "", nil
+	}
+
+	conf := a0client.Spec.Init
+	if conf == nil {
+		conf = a0client.Spec.Conf
+	}
+	if conf.Name == nil {
+		return "", nil
+	}
+
+	list, err := api.ListClients(ctx, url.Values{"fields": {"client_id,name,callbacks"}})
+	if err != nil {
+		return "", err
+	}
+	for _, item := range list {
+		if name, _ := item["name"].(string); strings.EqualFold(name, *conf.Name) {
+			id, _ := item["client_id"].(string)
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+// createConf returns the configuration to create the client from, Init or Conf when there is no Init, or an error
+// when the client cannot be created.
+func (r *A0ClientReconciler) createConf(a0client *v1.A0Client) (*v1.ClientConf, error) {
+	if err := checkCreatePolicy("A0Client", a0client); err != nil {
+		return nil, err
+	}
+
+	conf := a0client.Spec.Init
+	if conf == nil {
+		conf = a0client.Spec.Conf
+	}
+	if conf.ApplicationType == nil {
+		return nil, terminalError(v1.ReasonInvalidConfiguration, "A0Client %s/%s is missing a value for application type", a0client.Namespace, a0client.Name)
+	}
+
+	conf = conf.DeepCopy()
+	conf.EnabledConnections = nil
+	return conf, nil
+}
+
+// create creates the client from Init, or Conf when there is no Init.
+func (r *A0ClientReconciler) create(ctx context.Context, api *management.Client, a0client *v1.A0Client) (string, error) {
+	conf, err := r.createConf(a0client)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := drift.ToObject(conf)
+	if err != nil {
+		return "", err
+	}
+	created, err := api.CreateClient(ctx, withoutFields(body, "client_id", "enabled_connections"))
+	if err != nil {
+		return "", err
+	}
+	id, _ := created["client_id"].(string)
+	if id == "" {
+		return "", fmt.Errorf("A0Client %s/%s was created without a client ID", a0client.Namespace, a0client.Name)
+	}
+	return id, nil
+}
+
+// removeMissingMetadata sets the client_metadata keys of live that are missing from desired to null, since the
+// Management API merges client_metadata instead of replacing it.
+func removeMissingMetadata(desired, live management.Object) {
+	want, ok := desired["client_metadata"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	have, _ := live["client_metadata"].(map[string]interface{})
+	for k := range have {
+		if _, ok := want[k]; !ok {
+			want[k] = nil
+		}
+	}
+}
+
+// reconcileEnabledConnections enables the client for the connections in conf.enabled_connections and disables it
+// for all others, and returns the sorted IDs of the enabled connections. Connections are only changed in Apply mode.
+func (r *A0ClientReconciler) reconcileEnabledConnections(ctx context.Context, api *management.Client, a0client *v1.A0Client, id string) ([]interface{}, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	desired := map[string]bool{}
+	for _, ref := range a0client.Spec.Conf.EnabledConnections {
+		connectionID, err := resolveConnectionRefToID(ctx, r.Client, ref, a0client.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		desired[connectionID] = true
+	}
+
+	current, err := api.ListClientConnections(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	enabled := map[string]bool{}
+	for _, conn := range current {
+		if connectionID, _ := conn["id"].(string); connectionID != "" {
+			enabled[connectionID] = true
+		}
+	}
+
+	if a0client.GetMode() == v1.ReconcileModeApply && v1.HasPolicy(a0client, v1.PolicyTypeUpdate) {
+		for connectionID := range desired {
+			if !enabled[connectionID] {
+				log.Info("enabling connection for client", "id", id, "connection", connectionID)
+				if err := api.UpdateConnectionClients(ctx, connectionID, []management.Object{{"client_id": id, "status": true}}); err != nil {
+					return nil, err
+				}
+				enabled[connectionID] = true
+			}
+		}
+		for connectionID := range enabled {
+			if !desired[connectionID] {
+				log.Info("disabling connection for client", "id", id, "connection", connectionID)
+				if err := api.UpdateConnectionClients(ctx, connectionID, []management.Object{{"client_id": id, "status": false}}); err != nil {
+					return nil, err
+				}
+				delete(enabled, connectionID)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(enabled))
+	for connectionID := range enabled {
+		ids = append(ids, connectionID)
+	}
+	sort.Strings(ids)
+	out := make([]interface{}, len(ids))
+	for i, connectionID := range ids {
+		out[i] = connectionID
+	}
+	return out, nil
+}
+
+// applySecret writes the client ID and secret of live into the Secret named by spec.secretRef, creating the
+// Secret if it does not exist. An existing Secret is only updated if it is owned by the client.
+func (r *A0ClientReconciler) applySecret(ctx context.Context, a0client *v1.A0Client, live management.Object) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	ref := a0client.Spec.SecretRef
+	if ref == nil {
+		return nil
+	}
+	clientID, _ := live["client_id"].(string)
+	clientSecret, _ := live["client_secret"].(string)
+	if clientID == "" {
+		return fmt.Errorf("A0Client %s/%s has no client ID to write to its secret", a0client.Namespace, a0client.Name)
+	}
+
+	key := referenceKey(&ref.Namespace, &ref.Name, a0client.Namespace)
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, key, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		if err := r.setSecretOwner(a0client, secret); err != nil {
+			return err
+		}
+		secret.Data = secretData(clientID, clientSecret)
+		log.Info("creating client secret", "secret", key)
+		return r.Create(ctx, secret)
+	}
+
+	if !isSecretOwnedBy(secret, a0client) {
+		log.Info("secret is not owned by the client, leaving it unchanged", "secret", key)
+		return nil
+	}
+
+	data := secretData(clientID, clientSecret)
+	if bytes.Equal(secret.Data[management.ClientIDKey], data[management.ClientIDKey]) &&
+		bytes.Equal(secret.Data[management.ClientSecretKey], data[management.ClientSecretKey]) {
+		return nil
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for k, v := range data {
+		secret.Data[k] = v
+	}
+	log.Info("updating client secret", "secret", key)
+	return r.Update(ctx, secret)
+}
+
+func secretData(clientID, clientSecret string) map[string][]byte {
+	data := map[string][]byte{management.ClientIDKey: []byte(clientID)}
+	if clientSecret != "" {
+		data[management.ClientSecretKey] = []byte(clientSecret)
+	}
+	return data
+}
+
+// setSecretOwner makes a0client the owner of secret: with a controller reference in the same namespace, so that
+// the Secret is garbage collected with the client, and with ClientOwnerAnnotation in other namespaces.
+func (r *A0ClientReconciler) setSecretOwner(a0client *v1.A0Client, secret *corev1.Secret) error {
+	if secret.Namespace == a0client.Namespace {
+		return controllerutil.SetControllerReference(a0client, secret, r.Scheme)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[ClientOwnerAnnotation] = client.ObjectKeyFromObject(a0client).String()
+	return nil
+}
+
+func isSecretOwnedBy(secret *corev1.Secret, a0client *v1.A0Client) bool {
+	return metav1.IsControlledBy(secret, a0client) || secret.Annotations[ClientOwnerAnnotation] == client.ObjectKeyFromObject(a0client).String()
+}
+
+// finalize deletes the client from Auth0 when the policy allows it, deletes an owned Secret in another namespace,
+// which is not garbage collected, and releases the finalizer.
+func (r *A0ClientReconciler) finalize(ctx context.Context, a0client *v1.A0Client) (ctrl.Result, error) {
+	if ref := a0client.Spec.SecretRef; ref != nil && controllerutil.ContainsFinalizer(a0client, ClientFinalizer) {
+		key := referenceKey(&ref.Namespace, &ref.Name, a0client.Namespace)
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, key, secret); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		} else if err == nil && key.Namespace != a0client.Namespace && isSecretOwnedBy(secret, a0client) {
+			if err := r.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	return finalizeEntity(ctx, r.Client, r.Clients, a0client, ClientFinalizer, func(api *management.Client, id string) error {
+		return api.DeleteClient(ctx, id)
+	})
+}
+
+func (r *A0ClientReconciler) interval() time.Duration {
+	if r.Interval > 0 {
+		return r.Interval
+	}
+	return DefaultReconcileInterval
+}
+
+// enabledConnectionsIndexValues returns the EnabledConnectionsIndex values of a client.
+func enabledConnectionsIndexValues(obj client.Object) []string {
+	a0client := obj.(*v1.A0Client)
+	var values []string
+	if a0client.Spec.Conf != nil {
+		for _, ref := range a0client.Spec.Conf.EnabledConnections {
+			if ref.Name != nil {
+				values = appendUnique(values, referenceKey(ref.Namespace, ref.Name, a0client.Namespace).String())
+			}
+		}
+	}
+	return values
+}
+
+// clientsForConnection maps an A0Connection to the clients that enable it.
+func (r *A0ClientReconciler) clientsForConnection(ctx context.Context, obj client.Object) []reconcile.Request {
+	return requestsMatching(ctx, r.Client, &v1.A0ClientList{}, client.MatchingFields{EnabledConnectionsIndex: client.ObjectKeyFromObject(obj).String()})
+}
+
+// SetupWithManager registers the reconciler and its field index with mgr
+func (r *A0ClientReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1.A0Client{}, EnabledConnectionsIndex, enabledConnectionsIndexValues); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.A0Client{}, builder.WithPredicates(entityChangedPredicate(), partitionPredicate(r.Partition))).
+		Owns(&corev1.Secret{}).
+		Watches(&v1.A0Connection{}, handler.EnqueueRequestsFromMapFunc(r.clientsForConnection), builder.WithPredicates(dependencyReadyPredicate())).
+		Named("a0client").
+		Complete(r)
+}
@@ -0,0 +1,168 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+)
+
+func clientWith(name string, mutate func(spec *v1.A0ClientSpec)) *v1.A0Client {
+	c := a0Client(name)
+	c.Generation = 1
+	if mutate != nil {
+		mutate(&c.Spec)
+	}
+	return c
+}
+
+func (e *testEnv) clientReconciler() *controller.A0ClientReconciler {
+	return &controller.A0ClientReconciler{Client: e.kube, Scheme: e.kube.Scheme(), Clients: e.clients}
+}
+
+func TestClientCreatesAndWritesSecret(t *testing.T) {
+	env := newTestEnv(t, clientWith("app", func(spec *v1.A0ClientSpec) {
+		spec.SecretRef = &v1.V1SecretReference{Name: "app-credentials", Namespace: namespace}
+		spec.Init = &v1.ClientConf{Name: strPtr("app"), ApplicationType: strPtr("regular_web"), Description: strPtr("initial")}
+		spec.Conf.Callbacks = []string{"https://app.example.com/callback"}
+	}))
+	ctx := context.Background()
+
+	if _, err := env.clientReconciler().Reconcile(ctx, request("app")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	a0client := &v1.A0Client{}
+	env.get(t, "app", a0client)
+	id := a0client.GetAuth0ID()
+	live, ok := env.auth0.Get(fake.Clients, id)
+	if !ok || live["app_type"] != "regular_web" || live["description"] != "initial" {
+		t.Fatalf("expected the client to be created from init, got %v", live)
+	}
+	if callbacks, _ := live["callbacks"].([]interface{}); len(callbacks) != 1 {
+		t.Fatalf("expected conf to be applied, got %v", live)
+	}
+
+	var lastConf map[string]interface{}
+	if err := json.Unmarshal(a0client.Status.LastConf.Raw, &lastConf); err != nil {
+		t.Fatalf("failed to decode lastConf: %v", err)
+	}
+	if _, ok := lastConf["client_secret"]; ok || lastConf["client_id"] != id {
+		t.Fatalf("expected lastConf without the client secret, got %v", lastConf)
+	}
+
+	secret := &corev1.Secret{}
+	env.get(t, "app-credentials", secret)
+	if string(secret.Data[management.ClientIDKey]) != id || string(secret.Data[management.ClientSecretKey]) != live["client_secret"] {
+		t.Fatalf("unexpected secret data: %v", secret.Data)
+	}
+	if !metav1.IsControlledBy(secret, a0client) {
+		t.Fatalf("expected the secret to be owned by the client, got %v", secret.OwnerReferences)
+	}
+}
+
+func TestClientLeavesUnownedSecret(t *testing.T) {
+	env := newTestEnv(t,
+		clientWith("app", func(spec *v1.A0ClientSpec) {
+			spec.SecretRef = &v1.V1SecretReference{Name: "shared", Namespace: namespace}
+			spec.Conf.ApplicationType = strPtr("spa")
+		}),
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: namespace}, Data: map[string][]byte{"other": []byte("value")}},
+	)
+
+	if _, err := env.clientReconciler().Reconcile(context.Background(), request("app")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	secret := &corev1.Secret{}
+	env.get(t, "shared", secret)
+	if len(secret.Data) != 1 {
+		t.Fatalf("expected a secret that is not owned by the client to be left alone, got %v", secret.Data)
+	}
+}
+
+func TestClientFind(t *testing.T) {
+	env := newTestEnv(t,
+		clientWith("by-id", func(spec *v1.A0ClientSpec) {
+			spec.Find = &v1.ClientFind{ClientId: strPtr("client-by-id")}
+		}),
+		clientWith("strict", func(spec *v1.A0ClientSpec) {
+			spec.Find = &v1.ClientFind{CallbackUrls: []string{"https://a.example.com/cb", "https://B.example.com/cb"}}
+		}),
+		clientWith("loose", func(spec *v1.A0ClientSpec) {
+			spec.Find = &v1.ClientFind{CallbackUrls: []string{"https://c.example.com/cb", "https://missing.example.com/cb"}, CallbackUrlMatchMode: strPtr("loose")}
+		}),
+		clientWith("by-name", nil),
+	)
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-by-id", "name": "legacy"})
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-partial", "name": "partial", "callbacks": []string{"https://a.example.com/cb"}})
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-strict", "name": "strict-match", "callbacks": []string{"https://a.example.com/cb", "https://b.example.com/cb"}})
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-loose", "name": "loose-match", "callbacks": []string{"https://c.example.com/cb"}})
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-by-name", "name": "BY-NAME"})
+	r := env.clientReconciler()
+
+	for name, want := range map[string]string{"by-id": "client-by-id", "strict": "client-strict", "loose": "client-loose", "by-name": "client-by-name"} {
+		if _, err := r.Reconcile(context.Background(), request(name)); err != nil {
+			t.Fatalf("Reconcile %s failed: %v", name, err)
+		}
+		a0client := &v1.A0Client{}
+		env.get(t, name, a0client)
+		if a0client.GetAuth0ID() != want {
+			t.Errorf("expected %s to find %s, got %q", name, want, a0client.GetAuth0ID())
+		}
+	}
+	if n := len(env.auth0.List(fake.Clients)); n != 5 {
+		t.Fatalf("expected no client to be created, got %d clients", n)
+	}
+}
+
+func TestClientEnabledConnections(t *testing.T) {
+	env := newTestEnv(t,
+		clientWith("app", func(spec *v1.A0ClientSpec) {
+			spec.Find = &v1.ClientFind{ClientId: strPtr("client-1")}
+			spec.Conf.EnabledConnections = []v1.V1ConnectionReference{{Name: strPtr("users")}}
+		}),
+		&v1.A0Connection{
+			ObjectMeta: metav1.ObjectMeta{Name: "users", Namespace: namespace},
+			Spec:       v1.A0ConnectionSpec{TenantRef: tenantRef(), Conf: &v1.ConnectionConf{Name: strPtr("users")}},
+		},
+	)
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-1", "name": "app"})
+	users := env.auth0.Seed(fake.Connections, fake.Object{"name": "users", "strategy": "auth0", "enabled_clients": []string{}})
+	legacy := env.auth0.Seed(fake.Connections, fake.Object{"name": "legacy", "strategy": "auth0", "enabled_clients": []string{"client-1"}})
+	r := env.clientReconciler()
+	ctx := context.Background()
+
+	result, err := r.Reconcile(ctx, request("app"))
+	if err != nil || result.RequeueAfter == 0 {
+		t.Fatalf("expected a requeue while the connection is not reconciled, got %v, %v", result, err)
+	}
+	a0client := &v1.A0Client{}
+	env.get(t, "app", a0client)
+	if c := v1.GetCondition(a0client, v1.ConditionTypeReady); c == nil || c.Reason != v1.ReasonDependencyNotReady {
+		t.Fatalf("expected to wait for the connection, got %v", c)
+	}
+
+	conn := &v1.A0Connection{}
+	env.get(t, "users", conn)
+	conn.SetAuth0ID(users)
+	if err := env.kube.Status().Update(ctx, conn); err != nil {
+		t.Fatalf("failed to update connection status: %v", err)
+	}
+
+	if _, err := r.Reconcile(ctx, request("app")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if live, _ := env.auth0.Get(fake.Connections, users); len(live["enabled_clients"].([]interface{})) != 1 {
+		t.Fatalf("expected the client to be enabled for %s, got %v", users, live)
+	}
+	if live, _ := env.auth0.Get(fake.Connections, legacy); len(live["enabled_clients"].([]interface{})) != 0 {
+		t.Fatalf("expected the client to be disabled for %s, got %v", legacy, live)
+	}
+}
@@ -0,0 +1,355 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+const (
+	// ClientGrantFinalizer is the finalizer of A0ClientGrant, the same identifier the C# operator uses
+	ClientGrantFinalizer = "kubernetes.auth0.com/v1clientgrantfinalizer"
+
+	// ClientRefIndex indexes A0ClientGrant objects by the namespace/name of the A0Client they reference
+	ClientRefIndex = "spec.clientRef"
+
+	// AudienceIndex indexes A0ClientGrant objects by the namespace/name of the A0ResourceServer they reference,
+	// and by the identifier of the audience when it is given directly
+	AudienceIndex = "spec.audience"
+)
+
+// A0ClientGrantReconciler reconciles A0ClientGrant objects with the client grants of their tenant. Grants wait for
+// the A0Client and A0ResourceServer they reference to be reconciled, and are reconciled again as soon as they are.
+type A0ClientGrantReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Clients provides the Management API client of each tenant
+	Clients ClientProvider
+
+	// Interval is how often client grants are reconciled when nothing changes. Defaults to DefaultReconcileInterval.
+	Interval time.Duration
+
+	// Partition is the value of the v1.PartitionAnnotationKey annotation of the client grants to reconcile. When empty,
+	// only client grants without the annotation are reconciled.
+	Partition string
+}
+
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clientgrants,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clientgrants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clientgrants/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0clients,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0resourceservers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0tenants,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile implements reconcile.Reconciler
+func (r *A0ClientGrantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	grant := &v1.A0ClientGrant{}
+	if err := r.Get(ctx, req.NamespacedName, grant); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !v1.InPartition(grant, r.Partition) {
+		return ctrl.Result{}, nil
+	}
+
+	if !grant.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, grant)
+	}
+
+	patch := client.MergeFrom(grant.DeepCopy())
+	if controllerutil.AddFinalizer(grant, ClientGrantFinalizer) {
+		if err := r.Patch(ctx, grant, patch); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return finishReconcile(ctx, r.Client, grant, r.reconcile(ctx, grant), r.interval())
+}
+
+func (r *A0ClientGrantReconciler) reconcile(ctx context.Context, grant *v1.A0ClientGrant) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if grant.Spec.Conf == nil {
+		return terminalError(v1.ReasonInvalidConfiguration, "A0ClientGrant %s/%s is missing configuration", grant.Namespace, grant.Name)
+	}
+
+	api, err := apiClientFor(ctx, r.Client, r.Clients, grant)
+	if err != nil {
+		return err
+	}
+
+	mode := grant.GetMode()
+	id := grant.GetAuth0ID()
+	clientID, audience := lastClientAndAudience(grant)
+	if id == "" {
+		// a grant is identified by its client and audience, so both have to be resolved before it can be found
+		conf := grant.Spec.Init
+		if conf == nil {
+			conf = grant.Spec.Conf
+		}
+		if clientID, err = resolveClientRefToID(ctx, r.Client, conf.ClientRef, grant.Namespace); err != nil {
+			return err
+		}
+		if audience, err = resolveResourceServerRefToIdentifier(ctx, r.Client, api, conf.Audience, grant.Namespace); err != nil {
+			return err
+		}
+
+		if id, err = r.find(ctx, api, clientID, audience); err != nil {
+			return err
+		}
+		if id == "" && mode == v1.ReconcileModePlan {
+			if err := checkCreatePolicy("A0ClientGrant", grant); err != nil {
+				return err
+			}
+			init := &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Id: &clientID},
+				Audience:  &v1.V1ResourceServerReference{Identifier: &audience},
+				Scope:     conf.Scope,
+			}
+			return planCreate(grant, init, scopeConf(grant.Spec.Conf))
+		}
+		if id == "" && mode == v1.ReconcileModeObserve {
+			return nil
+		}
+		if id == "" {
+			if id, err = r.create(ctx, api, grant, conf, clientID, audience); err != nil {
+				return err
+			}
+			log.Info("created client grant", "id", id, "client", clientID, "audience", audience)
+		} else {
+			log.Info("found existing client grant", "id", id, "client", clientID, "audience", audience)
+		}
+
+		// persist the ID right away, so that a failure below cannot lead to a second client grant
+		grant.SetAuth0ID(id)
+		if err := r.Status().Update(ctx, grant); err != nil {
+			return err
+		}
+	}
+
+	live, err := r.get(ctx, api, id, clientID, audience)
+	if err != nil {
+		return err
+	}
+	if live == nil {
+		grant.SetAuth0ID("")
+		resetLastConf(grant)
+		return missingEntityError("A0ClientGrant", grant, id)
+	}
+
+	drifted, err := driftedFields(grant, scopeConf(grant.Spec.Conf), live)
+	if err != nil {
+		return err
+	}
+	if mode == v1.ReconcileModePlan {
+		if err := planUpdate(grant, scopeConf(grant.Spec.Conf), live, unmanagedFields(grant, drifted)); err != nil {
+			return err
+		}
+	}
+
+	if mode == v1.ReconcileModeApply && v1.HasPolicy(grant, v1.PolicyTypeUpdate) {
+		changed, err := drift.CompareIgnoring(scopeConf(grant.Spec.Conf), live, unmanagedFields(grant, drifted))
+		if err != nil {
+			return err
+		}
+		if len(changed) > 0 {
+			desired := management.Object{"scope": scopeOf(grant.Spec.Conf)}
+			drift.Omit(desired, unmanagedFields(grant, drifted))
+			log.Info("updating client grant", "id", id, "fields", differencePaths(changed))
+			if live, err = api.UpdateClientGrant(ctx, id, desired); err != nil {
+				return err
+			}
+		}
+	}
+
+	return recordLastConf(grant, live, drifted)
+}
+
+// get returns the client grant with the given ID, or nil. The Management API cannot get a single client grant, so
+// this lists the grants of clientID for audience, or all grants when they are not known.
+func (r *A0ClientGrantReconciler) get(ctx context.Context, api *management.Client, id, clientID, audience string) (management.Object, error) {
+	list, err := api.ListClientGrants(ctx, grantQuery(clientID, audience))
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list {
+		if itemID, _ := item["id"].(string); itemID == id {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+// find returns the ID of the client grant of clientID for audience, or an empty string.
+func (r *A0ClientGrantReconciler) find(ctx context.Context, api *management.Client, clientID, audience string) (string, error) {
+	list, err := api.ListClientGrants(ctx, grantQuery(clientID, audience))
+	if err != nil {
+		return "", err
+	}
+	for _, item := range list {
+		itemClientID, _ := item["client_id"].(string)
+		itemAudience, _ := item["audience"].(string)
+		if itemClientID == clientID && strings.EqualFold(itemAudience, audience) {
+			id, _ := item["id"].(string)
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+// grantQuery returns the query that lists the client grants of clientID for audience, leaving out empty filters.
+func grantQuery(clientID, audience string) url.Values {
+	query := url.Values{}
+	if clientID != "" {
+		query.Set("client_id", clientID)
+	}
+	if audience != "" {
+		query.Set("audience", audience)
+	}
+	return query
+}
+
+// lastClientAndAudience returns the client ID and audience of the grant as last returned by Auth0, which cannot
+// change once the grant exists, or empty strings when no grant has been reconciled yet.
+func lastClientAndAudience(grant *v1.A0ClientGrant) (string, string) {
+	last, err := grant.LastAppliedConf()
+	if err != nil || last == nil {
+		return "", ""
+	}
+	var clientID, audience string
+	if last.ClientRef != nil && last.ClientRef.Id != nil {
+		clientID = *last.ClientRef.Id
+	}
+	if last.Audience != nil && last.Audience.Identifier != nil {
+		audience = *last.Audience.Identifier
+	}
+	return clientID, audience
+}
+
+// create creates the client grant from conf, which is Init, or Conf when there is no Init.
+func (r *A0ClientGrantReconciler) create(ctx context.Context, api *management.Client, grant *v1.A0ClientGrant, conf *v1.ClientGrantConf, clientID, audience string) (string, error) {
+	if err := checkCreatePolicy("A0ClientGrant", grant); err != nil {
+		return "", err
+	}
+
+	created, err := api.CreateClientGrant(ctx, management.Object{
+		"client_id": clientID,
+		"audience":  audience,
+		"scope":     scopeOf(conf),
+	})
+	if err != nil {
+		return "", err
+	}
+	id, _ := created["id"].(string)
+	if id == "" {
+		return "", fmt.Errorf("A0ClientGrant %s/%s was created without an ID", grant.Namespace, grant.Name)
+	}
+	return id, nil
+}
+
+// finalize deletes the client grant from Auth0 when the policy allows it and releases the finalizer.
+func (r *A0ClientGrantReconciler) finalize(ctx context.Context, grant *v1.A0ClientGrant) (ctrl.Result, error) {
+	return finalizeEntity(ctx, r.Client, r.Clients, grant, ClientGrantFinalizer, func(api *management.Client, id string) error {
+		return api.DeleteClientGrant(ctx, id)
+	})
+}
+
+func (r *A0ClientGrantReconciler) interval() time.Duration {
+	if r.Interval > 0 {
+		return r.Interval
+	}
+	return DefaultReconcileInterval
+}
+
+// scopeConf returns the part of conf that can be updated, the scope, which is empty when conf has none.
+func scopeConf(conf *v1.ClientGrantConf) *v1.ClientGrantConf {
+	scope := []string{}
+	return &v1.ClientGrantConf{Scope: append(scope, conf.Scope...)}
+}
+
+// scopeOf returns the scope of conf as a Management API value, which is never null.
+func scopeOf(conf *v1.ClientGrantConf) []interface{} {
+	scope := []interface{}{}
+	for _, s := range conf.Scope {
+		scope = append(scope, s)
+	}
+	return scope
+}
+
+// clientRefIndexValues returns the ClientRefIndex values of a client grant.
+func clientRefIndexValues(obj client.Object) []string {
+	grant := obj.(*v1.A0ClientGrant)
+	var values []string
+	for _, conf := range []*v1.ClientGrantConf{grant.Spec.Init, grant.Spec.Conf} {
+		if conf != nil && conf.ClientRef != nil && conf.ClientRef.Name != nil {
+			values = appendUnique(values, referenceKey(conf.ClientRef.Namespace, conf.ClientRef.Name, grant.Namespace).String())
+		}
+	}
+	return values
+}
+
+// audienceIndexValues returns the AudienceIndex values of a client grant.
+func audienceIndexValues(obj client.Object) []string {
+	grant := obj.(*v1.A0ClientGrant)
+	var values []string
+	for _, conf := range []*v1.ClientGrantConf{grant.Spec.Init, grant.Spec.Conf} {
+		if conf == nil || conf.Audience == nil {
+			continue
+		}
+		if conf.Audience.Name != nil {
+			values = appendUnique(values, referenceKey(conf.Audience.Namespace, conf.Audience.Name, grant.Namespace).String())
+		}
+		if conf.Audience.Identifier != nil {
+			values = appendUnique(values, identifierIndexValue(*conf.Audience.Identifier))
+		}
+	}
+	return values
+}
+
+// grantsForClient maps an A0Client to the client grants that reference it.
+func (r *A0ClientGrantReconciler) grantsForClient(ctx context.Context, obj client.Object) []reconcile.Request {
+	return requestsMatching(ctx, r.Client, &v1.A0ClientGrantList{}, client.MatchingFields{ClientRefIndex: client.ObjectKeyFromObject(obj).String()})
+}
+
+// grantsForResourceServer maps an A0ResourceServer to the client grants that reference it by name or identifier.
+func (r *A0ClientGrantReconciler) grantsForResourceServer(ctx context.Context, obj client.Object) []reconcile.Request {
+	requests := requestsMatching(ctx, r.Client, &v1.A0ClientGrantList{}, client.MatchingFields{AudienceIndex: client.ObjectKeyFromObject(obj).String()})
+	if rs := obj.(*v1.A0ResourceServer); rs.Status.Identifier != nil {
+		requests = append(requests, requestsMatching(ctx, r.Client, &v1.A0ClientGrantList{}, client.MatchingFields{AudienceIndex: identifierIndexValue(*rs.Status.Identifier)})...)
+	}
+	return requests
+}
+
+// SetupWithManager registers the reconciler and its field indexes with mgr
+func (r *A0ClientGrantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctx := context.Background()
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1.A0ClientGrant{}, ClientRefIndex, clientRefIndexValues); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &v1.A0ClientGrant{}, AudienceIndex, audienceIndexValues); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.A0ClientGrant{}, builder.WithPredicates(entityChangedPredicate(), partitionPredicate(r.Partition))).
+		Watches(&v1.A0Client{}, handler.EnqueueRequestsFromMapFunc(r.grantsForClient), builder.WithPredicates(dependencyReadyPredicate())).
+		Watches(&v1.A0ResourceServer{}, handler.EnqueueRequestsFromMapFunc(r.grantsForResourceServer), builder.WithPredicates(dependencyReadyPredicate())).
+		Named("a0clientgrant").
+		Complete(r)
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+func TestGrantsForDependencies(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1.AddToScheme(scheme)
+
+	strPtr := func(s string) *string { return &s }
+	grant := func(name string, conf *v1.ClientGrantConf) *v1.A0ClientGrant {
+		return &v1.A0ClientGrant{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps"}, Spec: v1.A0ClientGrantSpec{Conf: conf}}
+	}
+
+	kube := ctrlfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			grant("by-name", &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Name: strPtr("app")},
+				Audience:  &v1.V1ResourceServerReference{Name: strPtr("api"), Namespace: strPtr("shared")},
+			}),
+			grant("by-identifier", &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Id: strPtr("client-1")},
+				Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("HTTPS://API.example.com")},
+			}),
+		).
+		WithIndex(&v1.A0ClientGrant{}, ClientRefIndex, clientRefIndexValues).
+		WithIndex(&v1.A0ClientGrant{}, AudienceIndex, audienceIndexValues).
+		Build()
+	r := &A0ClientGrantReconciler{Client: kube}
+	ctx := context.Background()
+
+	requests := r.grantsForClient(ctx, &v1.A0Client{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "apps"}})
+	if len(requests) != 1 || requests[0].Name != "by-name" {
+		t.Fatalf("expected the grant referencing the client by name, got %v", requests)
+	}
+
+	rs := &v1.A0ResourceServer{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "shared"}}
+	if requests := r.grantsForResourceServer(ctx, rs); len(requests) != 1 || requests[0].Name != "by-name" {
+		t.Fatalf("expected the grant referencing the resource server by name, got %v", requests)
+	}
+
+	rs.Status.Identifier = strPtr("https://api.example.com")
+	if requests := r.grantsForResourceServer(ctx, rs); len(requests) != 2 {
+		t.Fatalf("expected the grant referencing the identifier as well, got %v", requests)
+	}
+}
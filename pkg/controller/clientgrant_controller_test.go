@@ -0,0 +1,188 @@
+package controller_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+)
+
+func clientGrant(name string, mutate func(spec *v1.A0ClientGrantSpec)) *v1.A0ClientGrant {
+	grant := &v1.A0ClientGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+		Spec: v1.A0ClientGrantSpec{
+			TenantRef: tenantRef(),
+			Conf: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Name: strPtr("app")},
+				Audience:  &v1.V1ResourceServerReference{Name: strPtr("api")},
+				Scope:     []string{"read:things"},
+			},
+		},
+	}
+	if mutate != nil {
+		mutate(&grant.Spec)
+	}
+	return grant
+}
+
+func a0Client(name string) *v1.A0Client {
+	return &v1.A0Client{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1.A0ClientSpec{TenantRef: tenantRef(), Conf: &v1.ClientConf{Name: strPtr(name)}},
+	}
+}
+
+func (e *testEnv) clientGrantReconciler() *controller.A0ClientGrantReconciler {
+	return &controller.A0ClientGrantReconciler{Client: e.kube, Scheme: e.kube.Scheme(), Clients: e.clients}
+}
+
+func TestClientGrantWaitsForDependencies(t *testing.T) {
+	env := newTestEnv(t, clientGrant("grant", nil), a0Client("app"), resourceServer("api", nil))
+	r := env.clientGrantReconciler()
+	ctx := context.Background()
+
+	expectWaiting := func(message string) {
+		t.Helper()
+		result, err := r.Reconcile(ctx, request("grant"))
+		if err != nil || result.RequeueAfter == 0 {
+			t.Fatalf("expected a requeue while waiting for a dependency, got %v, %v", result, err)
+		}
+		grant := &v1.A0ClientGrant{}
+		env.get(t, "grant", grant)
+		c := v1.GetCondition(grant, v1.ConditionTypeReady)
+		if c == nil || c.Reason != v1.ReasonDependencyNotReady || c.Message != message {
+			t.Fatalf("expected to wait with %q, got %v", message, c)
+		}
+	}
+
+	expectWaiting("waiting for A0Client default/app to be reconciled")
+
+	// the A0Client reconciler records the ID of the client once it exists
+	clientID := env.auth0.Seed(fake.Clients, fake.Object{"name": "app"})
+	a0client := &v1.A0Client{}
+	env.get(t, "app", a0client)
+	a0client.SetAuth0ID(clientID)
+	if err := env.kube.Status().Update(ctx, a0client); err != nil {
+		t.Fatalf("failed to update client status: %v", err)
+	}
+
+	expectWaiting("waiting for A0ResourceServer default/api to be reconciled")
+
+	if _, err := env.resourceServerReconciler().Reconcile(ctx, request("api")); err != nil {
+		t.Fatalf("Reconcile of the resource server failed: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, request("grant")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	grant := &v1.A0ClientGrant{}
+	env.get(t, "grant", grant)
+	if grant.GetAuth0ID() == "" || grant.Status.LastConf == nil || !v1.IsReady(grant) {
+		t.Fatalf("expected the grant to be created, got %+v", grant.Status)
+	}
+	live := env.auth0.List(fake.ClientGrants)
+	if len(live) != 1 || live[0]["client_id"] != clientID || live[0]["audience"] != audience {
+		t.Fatalf("unexpected client grants in Auth0: %v", live)
+	}
+}
+
+func TestClientGrantFindsAndUpdates(t *testing.T) {
+	clientID := "client-1"
+	env := newTestEnv(t, clientGrant("grant", func(spec *v1.A0ClientGrantSpec) {
+		spec.Conf.ClientRef = &v1.V1ClientReference{Id: &clientID}
+		spec.Conf.Audience = &v1.V1ResourceServerReference{Identifier: strPtr(audience)}
+		spec.Conf.Scope = []string{"read:things", "write:things"}
+	}))
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": clientID, "name": "app"})
+	env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": audience})
+	id := env.auth0.Seed(fake.ClientGrants, fake.Object{"client_id": clientID, "audience": audience, "scope": []interface{}{"read:things"}})
+
+	if _, err := env.clientGrantReconciler().Reconcile(context.Background(), request("grant")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	grant := &v1.A0ClientGrant{}
+	env.get(t, "grant", grant)
+	if grant.GetAuth0ID() != id {
+		t.Fatalf("expected the existing grant %s to be adopted, got %q", id, grant.GetAuth0ID())
+	}
+	live, _ := env.auth0.Get(fake.ClientGrants, id)
+	if scope, _ := live["scope"].([]interface{}); len(scope) != 2 {
+		t.Fatalf("expected the scope to be updated, got %v", live)
+	}
+}
+
+func TestClientGrantListsByClientAndAudience(t *testing.T) {
+	clientID := "client-1"
+	env := newTestEnv(t, clientGrant("grant", func(spec *v1.A0ClientGrantSpec) {
+		spec.Conf.ClientRef = &v1.V1ClientReference{Id: &clientID}
+		spec.Conf.Audience = &v1.V1ResourceServerReference{Identifier: strPtr(audience)}
+	}))
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": clientID, "name": "app"})
+	env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": audience})
+	for i := 0; i < 5; i++ {
+		env.auth0.Seed(fake.ClientGrants, fake.Object{"client_id": fmt.Sprintf("other-%d", i), "audience": audience})
+	}
+	r := env.clientGrantReconciler()
+	ctx := context.Background()
+
+	// the first reconcile creates the grant, the second one reads it back
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(ctx, request("grant")); err != nil {
+			t.Fatalf("Reconcile failed: %v", err)
+		}
+	}
+
+	lists := 0
+	for _, req := range env.auth0.Requests() {
+		if req.Method != "GET" || req.Path != "/api/v2/client-grants" {
+			continue
+		}
+		lists++
+		if req.Query.Get("client_id") != clientID || req.Query.Get("audience") != audience {
+			t.Errorf("expected client grants to be listed by client and audience, got %v", req.Query)
+		}
+	}
+	if lists != 3 {
+		t.Errorf("got %d client grant lists, want one to find the grant and one per reconcile to read it", lists)
+	}
+}
+
+func TestClientGrantRecreatesMissing(t *testing.T) {
+	env := newTestEnv(t, clientGrant("grant", func(spec *v1.A0ClientGrantSpec) {
+		spec.Conf.ClientRef = &v1.V1ClientReference{Id: strPtr("client-1")}
+		spec.Conf.Audience = &v1.V1ResourceServerReference{Identifier: strPtr(audience)}
+	}))
+	env.auth0.Seed(fake.Clients, fake.Object{"client_id": "client-1", "name": "app"})
+	env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": audience})
+	r := env.clientGrantReconciler()
+	ctx := context.Background()
+
+	grant := &v1.A0ClientGrant{}
+	env.get(t, "grant", grant)
+	grant.SetAuth0ID("cgr_deleted")
+	if err := env.kube.Status().Update(ctx, grant); err != nil {
+		t.Fatalf("failed to update status: %v", err)
+	}
+
+	result, err := r.Reconcile(ctx, request("grant"))
+	if err != nil || result.RequeueAfter == 0 {
+		t.Fatalf("expected a quick requeue for a missing grant, got %v, %v", result, err)
+	}
+	env.get(t, "grant", grant)
+	if grant.GetAuth0ID() != "" {
+		t.Fatalf("expected the missing ID to be reset, got %q", grant.GetAuth0ID())
+	}
+
+	if _, err := r.Reconcile(ctx, request("grant")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if n := len(env.auth0.List(fake.ClientGrants)); n != 1 {
+		t.Fatalf("expected the grant to be created again, got %d", n)
+	}
+}
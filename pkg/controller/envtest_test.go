@@ -0,0 +1,78 @@
+package controller_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+)
+
+// newEnvTestEnv is newTestEnv backed by a real API server with the CRDs installed. It requires the envtest
+// binaries, see https://book.kubebuilder.io/reference/envtest, and skips the test when KUBEBUILDER_ASSETS is unset.
+func newEnvTestEnv(t *testing.T, objs ...client.Object) *testEnv {
+	t.Helper()
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set")
+	}
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("failed to start envtest: %v", err)
+	}
+	t.Cleanup(func() { _ = env.Stop() })
+
+	kube, err := client.New(cfg, client.Options{Scheme: newScheme()})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	s := fake.NewUnstartedServer()
+	s.StartTLS()
+	t.Cleanup(s.Close)
+
+	for _, obj := range append(tenantObjects(s), objs...) {
+		if err := kube.Create(context.Background(), obj); err != nil {
+			t.Fatalf("failed to create %s: %v", obj.GetName(), err)
+		}
+	}
+	return &testEnv{auth0: s, kube: kube, clients: management.NewCache(kube, s.Client())}
+}
+
+func TestResourceServerEnvTest(t *testing.T) {
+	env := newEnvTestEnv(t, resourceServer("api", func(spec *v1.A0ResourceServerSpec) {
+		spec.Policy = []v1.V1EntityPolicyType{v1.PolicyTypeCreate, v1.PolicyTypeUpdate, v1.PolicyTypeDelete}
+	}))
+	r := &controller.A0ResourceServerReconciler{Client: env.kube, Scheme: env.kube.Scheme(), Clients: env.clients}
+	ctx := context.Background()
+
+	if _, err := r.Reconcile(ctx, request("api")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "api", rs)
+	if rs.GetAuth0ID() == "" || !v1.IsReady(rs) {
+		t.Fatalf("expected the resource server to be created and ready, got %+v", rs.Status)
+	}
+
+	if err := env.kube.Delete(ctx, rs); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, request("api")); err != nil {
+		t.Fatalf("Reconcile after deletion failed: %v", err)
+	}
+	if n := len(env.auth0.List(fake.ResourceServers)); n != 0 {
+		t.Fatalf("expected the resource server to be deleted from Auth0, got %d", n)
+	}
+}
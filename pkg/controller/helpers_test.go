@@ -0,0 +1,90 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+const namespace = "default"
+
+func strPtr(s string) *string { return &s }
+
+func int32Ptr(i int32) *int32 { return &i }
+
+// testEnv is a fake Auth0 tenant together with a fake Kubernetes API holding an A0Tenant for it
+type testEnv struct {
+	auth0   *fake.Server
+	kube    client.Client
+	clients *management.Cache
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1.AddToScheme(scheme)
+	return scheme
+}
+
+// tenantObjects returns an A0Tenant named "tenant" for s and the Secret holding its credentials.
+func tenantObjects(s *fake.Server) []client.Object {
+	return []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "auth0", Namespace: namespace},
+			Data: map[string][]byte{
+				management.ClientIDKey:     []byte(s.ClientID),
+				management.ClientSecretKey: []byte(s.ClientSecret),
+			},
+		},
+		&v1.A0Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: namespace},
+			Spec: v1.A0TenantSpec{
+				Name: "tenant",
+				Auth: &v1.TenantAuth{Domain: strPtr(s.Domain()), SecretRef: &v1.V1SecretReference{Name: "auth0", Namespace: namespace}},
+			},
+		},
+	}
+}
+
+func newTestEnv(t *testing.T, objs ...client.Object) *testEnv {
+	t.Helper()
+
+	s := fake.NewUnstartedServer()
+	s.StartTLS()
+	t.Cleanup(s.Close)
+
+	kube := ctrlfake.NewClientBuilder().
+		WithScheme(newScheme()).
+		WithObjects(append(tenantObjects(s), objs...)...).
+		WithStatusSubresource(&v1.A0Client{}, &v1.A0Connection{}, &v1.A0ClientGrant{}, &v1.A0ResourceServer{}, &v1.A0Tenant{}).
+		Build()
+
+	return &testEnv{auth0: s, kube: kube, clients: management.NewCache(kube, s.Client())}
+}
+
+func tenantRef() *v1.V1TenantReference {
+	return &v1.V1TenantReference{Name: "tenant"}
+}
+
+func request(name string) ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+}
+
+func (e *testEnv) get(t *testing.T, name string, obj client.Object) {
+	t.Helper()
+	if err := e.kube.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		t.Fatalf("failed to get %s: %v", name, err)
+	}
+}
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+// referenceKey returns the namespace/name key of a reference, defaulting to the namespace of the referencing entity.
+func referenceKey(namespace *string, name *string, defaultNamespace string) types.NamespacedName {
+	key := types.NamespacedName{Namespace: defaultNamespace}
+	if namespace != nil && *namespace != "" {
+		key.Namespace = *namespace
+	}
+	if name != nil {
+		key.Name = *name
+	}
+	return key
+}
+
+// resolveClientRefToID returns the Auth0 client ID of ref. A reference by name waits for the A0Client to exist and
+// to have been reconciled.
+func resolveClientRefToID(ctx context.Context, c client.Reader, ref *v1.V1ClientReference, defaultNamespace string) (string, error) {
+	if ref == nil {
+		return "", terminalError(v1.ReasonInvalidConfiguration, "clientRef is required")
+	}
+	if ref.Id != nil && *ref.Id != "" {
+		return *ref.Id, nil
+	}
+	if ref.Name == nil || *ref.Name == "" {
+		return "", terminalError(v1.ReasonInvalidConfiguration, "client reference has no name")
+	}
+
+	key := referenceKey(ref.Namespace, ref.Name, defaultNamespace)
+	a0client := &v1.A0Client{}
+	if err := c.Get(ctx, key, a0client); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for A0Client %s to exist", key)
+		}
+		return "", err
+	}
+	if a0client.GetAuth0ID() == "" {
+		return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for A0Client %s to be reconciled", key)
+	}
+	return a0client.GetAuth0ID(), nil
+}
+
+// resolveResourceServerRefToIdentifier returns the identifier of the resource server referenced by ref. An identifier
+// is used as is, an ID is looked up in Auth0, and a reference by name waits for the A0ResourceServer to exist and
+// to have been reconciled.
+func resolveResourceServerRefToIdentifier(ctx context.Context, c client.Reader, api *management.Client, ref *v1.V1ResourceServerReference, defaultNamespace string) (string, error) {
+	if ref == nil {
+		return "", terminalError(v1.ReasonInvalidConfiguration, "audience is required")
+	}
+	if ref.Identifier != nil && *ref.Identifier != "" {
+		return *ref.Identifier, nil
+	}
+
+	if ref.Id != nil && *ref.Id != "" {
+		rs, err := api.GetResourceServer(ctx, *ref.Id)
+		if err != nil {
+			if management.IsNotFound(err) {
+				return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for resource server %s to exist in Auth0", *ref.Id)
+			}
+			return "", err
+		}
+		identifier, _ := rs["identifier"].(string)
+		return identifier, nil
+	}
+
+	if ref.Name == nil || *ref.Name == "" {
+		return "", terminalError(v1.ReasonInvalidConfiguration, "resource server reference has no identifier, name or id")
+	}
+
+	key := referenceKey(ref.Namespace, ref.Name, defaultNamespace)
+	rs := &v1.A0ResourceServer{}
+	if err := c.Get(ctx, key, rs); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for A0ResourceServer %s to exist", key)
+		}
+		return "", err
+	}
+	if rs.Status.Identifier == nil || *rs.Status.Identifier == "" {
+		return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for A0ResourceServer %s to be reconciled", key)
+	}
+	return *rs.Status.Identifier, nil
+}
+
+// identifierIndexValue is the index value of a reference to a resource server by identifier. Identifiers are
+// compared case-insensitively, the same way the operator finds resource servers.
+func identifierIndexValue(identifier string) string {
+	return "identifier:" + strings.ToLower(identifier)
+}
+
+// resolveConnectionRefToID returns the Auth0 ID of the connection referenced by ref. A reference by name waits for
+// the A0Connection to exist and to have been reconciled.
+func resolveConnectionRefToID(ctx context.Context, c client.Reader, ref v1.V1ConnectionReference, defaultNamespace string) (string, error) {
+	if ref.Id != nil && *ref.Id != "" {
+		return *ref.Id, nil
+	}
+	if ref.Name == nil || *ref.Name == "" {
+		return "", terminalError(v1.ReasonInvalidConfiguration, "connection reference has no name or id")
+	}
+
+	key := referenceKey(ref.Namespace, ref.Name, defaultNamespace)
+	conn := &v1.A0Connection{}
+	if err := c.Get(ctx, key, conn); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for A0Connection %s to exist", key)
+		}
+		return "", err
+	}
+	if conn.GetAuth0ID() == "" {
+		return "", dependencyError(v1.ReasonDependencyNotReady, "waiting for A0Connection %s to be reconciled", key)
+	}
+	return conn.GetAuth0ID(), nil
+}
+
+// requestsMatching lists the objects of list that match fields and returns a reconcile request for each of them.
+func requestsMatching(ctx context.Context, c client.Reader, list client.ObjectList, fields client.MatchingFields) []reconcile.Request {
+	if err := c.List(ctx, list, fields); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "unable to list referencing objects", "fields", fields)
+		return nil
+	}
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(obj runtime.Object) error {
+		if o, ok := obj.(client.Object); ok {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+		}
+		return nil
+	})
+	return requests
+}
+
+// dependencyReadyPredicate triggers on referenced entities that are created or whose Auth0 ID or identifier changes.
+func dependencyReadyPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldEntity, ok1 := e.ObjectOld.(v1.TenantEntity)
+			newEntity, ok2 := e.ObjectNew.(v1.TenantEntity)
+			if !ok1 || !ok2 {
+				return false
+			}
+			if oldEntity.GetAuth0ID() != newEntity.GetAuth0ID() {
+				return true
+			}
+			oldRS, ok1 := e.ObjectOld.(*v1.A0ResourceServer)
+			newRS, ok2 := e.ObjectNew.(*v1.A0ResourceServer)
+			return ok1 && ok2 && stringValue(oldRS.Status.Identifier) != stringValue(newRS.Status.Identifier)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
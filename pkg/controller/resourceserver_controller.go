@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// ResourceServerFinalizer is the finalizer of A0ResourceServer, the same identifier the C# operator uses
+const ResourceServerFinalizer = "kubernetes.auth0.com/v1resourceserverfinalizer"
+
+// A0ResourceServerReconciler reconciles A0ResourceServer objects with the resource servers of their tenant
+type A0ResourceServerReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Clients provides the Management API client of each tenant
+	Clients ClientProvider
+
+	// Interval is how often resource servers are reconciled when nothing changes. Defaults to DefaultReconcileInterval.
+	Interval time.Duration
+
+	// Partition is the value of the v1.PartitionAnnotationKey annotation of the resource servers to reconcile. When empty,
+	// only resource servers without the annotation are reconciled.
+	Partition string
+}
+
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0resourceservers,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0resourceservers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0resourceservers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kubernetes.auth0.com,resources=a0tenants,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile implements reconcile.Reconciler
+func (r *A0ResourceServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rs := &v1.A0ResourceServer{}
+	if err := r.Get(ctx, req.NamespacedName, rs); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// references and requeues can lead here for objects of another partition
+	if !v1.InPartition(rs, r.Partition) {
+		return ctrl.Result{}, nil
+	}
+
+	if !rs.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, rs)
+	}
+
+	patch := client.MergeFrom(rs.DeepCopy())
+	if controllerutil.AddFinalizer(rs, ResourceServerFinalizer) {
+		if err := r.Patch(ctx, rs, patch); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return finishReconcile(ctx, r.Client, rs, r.reconcile(ctx, rs), r.interval())
+}
+
+func (r *A0ResourceServerReconciler) reconcile(ctx context.Context, rs *v1.A0ResourceServer) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if rs.Spec.Conf == nil {
+		return terminalError(v1.ReasonInvalidConfiguration, "A0ResourceServer %s/%s is missing configuration", rs.Namespace, rs.Name)
+	}
+
+	api, err := apiClientFor(ctx, r.Client, r.Clients, rs)
+	if err != nil {
+		return err
+	}
+
+	mode := rs.GetMode()
+	id := rs.GetAuth0ID()
+	if id == "" {
+		if id, err = r.find(ctx, api, rs); err != nil {
+			return err
+		}
+		if id == "" && mode == v1.ReconcileModePlan {
+			init, err := r.createConf(rs)
+			if err != nil {
+				return err
+			}
+			return planCreate(rs, init, withoutIdentifier(rs.Spec.Conf))
+		}
+		if id == "" && mode == v1.ReconcileModeObserve {
+			return nil
+		}
+		if id == "" {
+			if id, err = r.create(ctx, api, rs); err != nil {
+				return err
+			}
+			log.Info("created resource server", "id", id)
+		} else {
+			log.Info("found existing resource server", "id", id)
+		}
+
+		// persist the ID right away, so that a failure below cannot lead to a second resource server
+		rs.SetAuth0ID(id)
+		if err := r.Status().Update(ctx, rs); err != nil {
+			return err
+		}
+	}
+
+	live, err := api.GetResourceServer(ctx, id)
+	if management.IsNotFound(err) {
+		rs.SetAuth0ID("")
+		resetLastConf(rs)
+		rs.Status.Identifier = nil
+		return missingEntityError("A0ResourceServer", rs, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	drifted, err := driftedFields(rs, withoutIdentifier(rs.Spec.Conf), live)
+	if err != nil {
+		return err
+	}
+	if mode == v1.ReconcileModePlan {
+		if err := planUpdate(rs, withoutIdentifier(rs.Spec.Conf), live, unmanagedFields(rs, drifted)); err != nil {
+			return err
+		}
+	}
+
+	if mode == v1.ReconcileModeApply && v1.HasPolicy(rs, v1.PolicyTypeUpdate) {
+		changed, err := drift.CompareIgnoring(withoutIdentifier(rs.Spec.Conf), live, unmanagedFields(rs, drifted))
+		if err != nil {
+			return err
+		}
+		if len(changed) > 0 {
+			desired, err := drift.ToObject(rs.Spec.Conf)
+			if err != nil {
+				return err
+			}
+			desired = withoutFields(desired, "id", "identifier")
+			drift.Omit(desired, unmanagedFields(rs, drifted))
+
+			log.Info("updating resource server", "id", id, "fields", differencePaths(changed))
+			if live, err = api.UpdateResourceServer(ctx, id, desired); err != nil {
+				return err
+			}
+		}
+	}
+
+	identifier, _ := live["identifier"].(string)
+	if identifier == "" {
+		return fmt.Errorf("A0ResourceServer %s/%s has missing Identifier", rs.Namespace, rs.Name)
+	}
+	rs.Status.Identifier = &identifier
+
+	return recordLastConf(rs, live, drifted)
+}
+
+// find returns the ID of the resource server with the identifier of the spec, or an empty string.
+func (r *A0ResourceServerReconciler) find(ctx context.Context, api *management.Client, rs *v1.A0ResourceServer) (string, error) {
+	conf := rs.Spec.Init
+	if conf == nil {
+		conf = rs.Spec.Conf
+	}
+	if conf.Identifier == nil || *conf.Identifier == "" {
+		return "", nil
+	}
+
+	list, err := api.ListResourceServers(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, item := range list {
+		if identifier, _ := item["identifier"].(string); strings.EqualFold(identifier, *conf.Identifier) {
+			id, _ := item["id"].(string)
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+// createConf returns the configuration to create the resource server from, Init or Conf when there is no Init, or
+// an error when the policy does not include Create.
+func (r *A0ResourceServerReconciler) createConf(rs *v1.A0ResourceServer) (*v1.ResourceServerConf, error) {
+	if err := checkCreatePolicy("A0ResourceServer", rs); err != nil {
+		return nil, err
+	}
+	if rs.Spec.Init != nil {
+		return rs.Spec.Init, nil
+	}
+	return rs.Spec.Conf, nil
+}
+
+// create creates the resource server from Init, or Conf when there is no Init.
+func (r *A0ResourceServerReconciler) create(ctx context.Context, api *management.Client, rs *v1.A0ResourceServer) (string, error) {
+	conf, err := r.createConf(rs)
+	if err != nil {
+		return "", err
+	}
+	body, err := drift.ToObject(conf)
+	if err != nil {
+		return "", err
+	}
+
+	created, err := api.CreateResourceServer(ctx, withoutFields(body, "id"))
+	if err != nil {
+		return "", err
+	}
+	id, _ := created["id"].(string)
+	if id == "" {
+		return "", fmt.Errorf("A0ResourceServer %s/%s was created without an ID", rs.Namespace, rs.Name)
+	}
+	return id, nil
+}
+
+// finalize deletes the resource server from Auth0 when the policy allows it and releases the finalizer.
+func (r *A0ResourceServerReconciler) finalize(ctx context.Context, rs *v1.A0ResourceServer) (ctrl.Result, error) {
+	return finalizeEntity(ctx, r.Client, r.Clients, rs, ResourceServerFinalizer, func(api *management.Client, id string) error {
+		return api.DeleteResourceServer(ctx, id)
+	})
+}
+
+// withoutIdentifier returns a copy of conf without the identifier, which cannot be changed.
+func withoutIdentifier(conf *v1.ResourceServerConf) *v1.ResourceServerConf {
+	conf = conf.DeepCopy()
+	conf.Identifier = nil
+	return conf
+}
+
+func (r *A0ResourceServerReconciler) interval() time.Duration {
+	if r.Interval > 0 {
+		return r.Interval
+	}
+	return DefaultReconcileInterval
+}
+
+// SetupWithManager registers the reconciler with mgr
+func (r *A0ResourceServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.A0ResourceServer{}, builder.WithPredicates(entityChangedPredicate(), partitionPredicate(r.Partition))).
+		Named("a0resourceserver").
+		Complete(r)
+}
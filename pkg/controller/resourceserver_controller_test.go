@@ -0,0 +1,386 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+)
+
+const audience = "https://api.example.com"
+
+func resourceServer(name string, mutate func(spec *v1.A0ResourceServerSpec)) *v1.A0ResourceServer {
+	rs := &v1.A0ResourceServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+		Spec: v1.A0ResourceServerSpec{
+			TenantRef: tenantRef(),
+			Conf:      &v1.ResourceServerConf{Identifier: strPtr(audience), Name: strPtr("Example API")},
+		},
+	}
+	if mutate != nil {
+		mutate(&rs.Spec)
+	}
+	return rs
+}
+
+func (e *testEnv) resourceServerReconciler() *controller.A0ResourceServerReconciler {
+	return &controller.A0ResourceServerReconciler{Client: e.kube, Scheme: e.kube.Scheme(), Clients: e.clients}
+}
+
+func TestResourceServerCreatesWithInit(t *testing.T) {
+	env := newTestEnv(t, resourceServer("api", func(spec *v1.A0ResourceServerSpec) {
+		spec.Init = &v1.ResourceServerConf{Identifier: strPtr(audience), Name: strPtr("Initial"), TokenLifetime: int32Ptr(3600)}
+	}))
+	r := env.resourceServerReconciler()
+
+	result, err := r.Reconcile(context.Background(), request("api"))
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if result.RequeueAfter != controller.DefaultReconcileInterval {
+		t.Fatalf("expected requeue after the reconcile interval, got %v", result.RequeueAfter)
+	}
+
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "api", rs)
+	if rs.Status.Id == nil || rs.Status.Identifier == nil || *rs.Status.Identifier != audience {
+		t.Fatalf("expected status to hold the id and identifier, got %+v", rs.Status)
+	}
+	if rs.Status.LastConf == nil || !v1.IsReady(rs) {
+		t.Fatalf("expected LastConf and a Ready condition, got %+v", rs.Status)
+	}
+	if len(rs.Finalizers) != 1 || rs.Finalizers[0] != controller.ResourceServerFinalizer {
+		t.Fatalf("expected the finalizer to be added, got %v", rs.Finalizers)
+	}
+
+	live, ok := env.auth0.Get(fake.ResourceServers, *rs.Status.Id)
+	if !ok {
+		t.Fatalf("expected the resource server to exist in Auth0")
+	}
+	// init is only used for creation, conf is applied on top of it
+	if live["name"] != "Example API" || live["token_lifetime"] != float64(3600) {
+		t.Fatalf("unexpected resource server in Auth0: %v", live)
+	}
+}
+
+func TestResourceServerFindsExisting(t *testing.T) {
+	env := newTestEnv(t, resourceServer("api", nil))
+	id := env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": audience, "name": "Old name"})
+
+	if _, err := env.resourceServerReconciler().Reconcile(context.Background(), request("api")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "api", rs)
+	if rs.GetAuth0ID() != id {
+		t.Fatalf("expected the existing resource server %s to be adopted, got %q", id, rs.GetAuth0ID())
+	}
+	if live, _ := env.auth0.Get(fake.ResourceServers, id); live["name"] != "Example API" {
+		t.Fatalf("expected conf to be applied, got %v", live)
+	}
+	if n := len(env.auth0.List(fake.ResourceServers)); n != 1 {
+		t.Fatalf("expected no resource server to be created, got %d", n)
+	}
+}
+
+func TestResourceServerHonorsPolicy(t *testing.T) {
+	env := newTestEnv(t,
+		resourceServer("no-create", func(spec *v1.A0ResourceServerSpec) {
+			spec.Policy = []v1.V1EntityPolicyType{v1.PolicyTypeUpdate}
+		}),
+		resourceServer("no-update", func(spec *v1.A0ResourceServerSpec) {
+			spec.Policy = []v1.V1EntityPolicyType{v1.PolicyTypeCreate}
+			spec.Conf.Identifier = strPtr("https://other.example.com")
+		}),
+	)
+	r := env.resourceServerReconciler()
+	ctx := context.Background()
+
+	result, err := r.Reconcile(ctx, request("no-create"))
+	if err != nil || result.RequeueAfter != 0 {
+		t.Fatalf("expected no retry without the Create policy, got %v, %v", result, err)
+	}
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "no-create", rs)
+	if c := v1.GetCondition(rs, v1.ConditionTypeReady); c == nil || c.Reason != v1.ReasonPolicyDenied {
+		t.Fatalf("expected a PolicyDenied condition, got %v", c)
+	}
+	if n := len(env.auth0.List(fake.ResourceServers)); n != 0 {
+		t.Fatalf("expected nothing to be created, got %d", n)
+	}
+
+	id := env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": "https://other.example.com", "name": "Managed elsewhere"})
+	if _, err := r.Reconcile(ctx, request("no-update")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if live, _ := env.auth0.Get(fake.ResourceServers, id); live["name"] != "Managed elsewhere" {
+		t.Fatalf("expected no update without the Update policy, got %v", live)
+	}
+}
+
+func TestResourceServerKeepsEmptyPolicy(t *testing.T) {
+	env := newTestEnv(t,
+		resourceServer("frozen", func(spec *v1.A0ResourceServerSpec) {
+			spec.Policy = []v1.V1EntityPolicyType{}
+		}),
+		resourceServer("new", func(spec *v1.A0ResourceServerSpec) {
+			spec.Policy = []v1.V1EntityPolicyType{}
+			spec.Conf.Identifier = strPtr("https://new.example.com")
+		}),
+	)
+	id := env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": audience, "name": "Frozen"})
+	r := env.resourceServerReconciler()
+
+	// the finalizer is added on the first reconcile, the second one runs against what it wrote
+	for i := 0; i < 2; i++ {
+		for _, name := range []string{"frozen", "new"} {
+			if _, err := r.Reconcile(context.Background(), request(name)); err != nil {
+				t.Fatalf("Reconcile %s failed: %v", name, err)
+			}
+		}
+	}
+
+	for _, name := range []string{"frozen", "new"} {
+		rs := &v1.A0ResourceServer{}
+		env.get(t, name, rs)
+		if rs.Spec.Policy == nil || len(rs.Finalizers) != 1 {
+			t.Fatalf("expected the finalizer to be added and the empty policy of %s to be kept, got %#v", name, rs.Spec.Policy)
+		}
+	}
+	if live, _ := env.auth0.Get(fake.ResourceServers, id); live["name"] != "Frozen" {
+		t.Fatalf("expected no update with an empty policy, got %v", live)
+	}
+	if n := len(env.auth0.List(fake.ResourceServers)); n != 1 {
+		t.Fatalf("expected nothing to be created with an empty policy, got %d", n)
+	}
+}
+
+func TestResourceServerFinalizer(t *testing.T) {
+	env := newTestEnv(t,
+		resourceServer("keep", nil),
+		resourceServer("delete", func(spec *v1.A0ResourceServerSpec) {
+			spec.Policy = []v1.V1EntityPolicyType{v1.PolicyTypeCreate, v1.PolicyTypeUpdate, v1.PolicyTypeDelete}
+			spec.Conf.Identifier = strPtr("https://delete.example.com")
+		}),
+	)
+	r := env.resourceServerReconciler()
+	ctx := context.Background()
+
+	for _, name := range []string{"keep", "delete"} {
+		if _, err := r.Reconcile(ctx, request(name)); err != nil {
+			t.Fatalf("Reconcile %s failed: %v", name, err)
+		}
+		rs := &v1.A0ResourceServer{}
+		env.get(t, name, rs)
+		if err := env.kube.Delete(ctx, rs); err != nil {
+			t.Fatalf("Delete %s failed: %v", name, err)
+		}
+		if _, err := r.Reconcile(ctx, request(name)); err != nil {
+			t.Fatalf("Reconcile %s after deletion failed: %v", name, err)
+		}
+		if err := env.kube.Get(ctx, request(name).NamespacedName, rs); !apierrors.IsNotFound(err) {
+			t.Fatalf("expected %s to be gone after finalization, got %v", name, err)
+		}
+	}
+
+	live := env.auth0.List(fake.ResourceServers)
+	if len(live) != 1 || live[0]["identifier"] != audience {
+		t.Fatalf("expected only the resource server without the Delete policy to remain, got %v", live)
+	}
+}
+
+func TestResourceServerTenantNotFound(t *testing.T) {
+	env := newTestEnv(t, resourceServer("api", func(spec *v1.A0ResourceServerSpec) {
+		spec.TenantRef = &v1.V1TenantReference{Name: "missing"}
+	}))
+
+	result, err := env.resourceServerReconciler().Reconcile(context.Background(), request("api"))
+	if err != nil || result.RequeueAfter == 0 {
+		t.Fatalf("expected a requeue while the tenant is missing, got %v, %v", result, err)
+	}
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "api", rs)
+	if c := v1.GetCondition(rs, v1.ConditionTypeReady); c == nil || c.Reason != v1.ReasonTenantNotFound {
+		t.Fatalf("expected a TenantNotFound condition, got %v", c)
+	}
+}
+
+func TestResourceServerPartition(t *testing.T) {
+	env := newTestEnv(t,
+		resourceServer("east", func(spec *v1.A0ResourceServerSpec) { spec.Conf.Identifier = strPtr("https://east.example.com") }),
+		resourceServer("default", nil),
+	)
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "east", rs)
+	rs.Annotations = map[string]string{v1.PartitionAnnotationKey: "east"}
+	if err := env.kube.Update(context.Background(), rs); err != nil {
+		t.Fatalf("failed to annotate: %v", err)
+	}
+
+	r := env.resourceServerReconciler()
+	for _, name := range []string{"east", "default"} {
+		if _, err := r.Reconcile(context.Background(), request(name)); err != nil {
+			t.Fatalf("Reconcile %s failed: %v", name, err)
+		}
+	}
+	env.get(t, "east", rs)
+	if rs.GetAuth0ID() != "" || len(rs.Finalizers) != 0 {
+		t.Fatalf("expected a resource server of another partition to be skipped, got %+v", rs.Status)
+	}
+
+	r.Partition = "east"
+	if _, err := r.Reconcile(context.Background(), request("east")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	env.get(t, "east", rs)
+	if rs.GetAuth0ID() == "" {
+		t.Fatalf("expected the resource server to be reconciled by its partition")
+	}
+	if n := len(env.auth0.List(fake.ResourceServers)); n != 2 {
+		t.Fatalf("expected both resource servers to be created, got %d", n)
+	}
+}
+
+func TestResourceServerPlanMode(t *testing.T) {
+	env := newTestEnv(t,
+		resourceServer("new", func(spec *v1.A0ResourceServerSpec) {
+			spec.Mode = v1.ReconcileModePlan
+			spec.Conf.Identifier = strPtr("https://new.example.com")
+		}),
+		resourceServer("api", func(spec *v1.A0ResourceServerSpec) {
+			spec.Mode = v1.ReconcileModePlan
+		}),
+	)
+	id := env.auth0.Seed(fake.ResourceServers, fake.Object{"identifier": audience, "name": "Old name"})
+	r := env.resourceServerReconciler()
+	ctx := context.Background()
+
+	for _, name := range []string{"new", "api"} {
+		if _, err := r.Reconcile(ctx, request(name)); err != nil {
+			t.Fatalf("Reconcile %s failed: %v", name, err)
+		}
+	}
+	for _, req := range env.auth0.Requests() {
+		if req.Method != "GET" && req.Path != "/oauth/token" {
+			t.Fatalf("expected no changes to Auth0 in Plan mode, got %s %s", req.Method, req.Path)
+		}
+	}
+
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "new", rs)
+	if p := rs.Status.Plan; p == nil || p.Action != v1.PlanActionCreate || len(p.Changes) != 2 || v1.IsReady(rs) {
+		t.Fatalf("expected a plan to create the resource server, got %v", p)
+	}
+
+	env.get(t, "api", rs)
+	if rs.GetAuth0ID() != id || v1.IsReady(rs) {
+		t.Fatalf("expected the existing resource server to be found and not ready, got %+v", rs.Status)
+	}
+	if got := rs.Status.Plan.String(); got != `Update
+  ~ name: "Old name" -> "Example API"` {
+		t.Fatalf("unexpected plan:\n%s", got)
+	}
+
+	rs.Spec.Mode = v1.ReconcileModeApply
+	if err := env.kube.Update(ctx, rs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(ctx, request("api")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	env.get(t, "api", rs)
+	if live, _ := env.auth0.Get(fake.ResourceServers, id); live["name"] != "Example API" || rs.Status.Plan != nil || !v1.IsReady(rs) {
+		t.Fatalf("expected the plan to be applied and cleared, got %v, %+v", live, rs.Status)
+	}
+}
+
+func TestResourceServerUpdatesOnlyWhenPlanned(t *testing.T) {
+	env := newTestEnv(t, resourceServer("api", func(spec *v1.A0ResourceServerSpec) {
+		spec.Conf.Scopes = []v1.ResourceServerScope{{Value: strPtr("read:items")}, {Value: strPtr("write:items")}}
+	}))
+	// Auth0 returns the scopes in another order, which Plan mode does not count as a change
+	env.auth0.Seed(fake.ResourceServers, fake.Object{
+		"identifier": audience,
+		"name":       "Example API",
+		"scopes":     []interface{}{map[string]interface{}{"value": "write:items"}, map[string]interface{}{"value": "read:items"}},
+	})
+
+	if _, err := env.resourceServerReconciler().Reconcile(context.Background(), request("api")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	for _, req := range env.auth0.Requests() {
+		if req.Method == "PATCH" {
+			t.Fatalf("expected no update when Plan mode would plan none, got %s %s", req.Method, req.Path)
+		}
+	}
+}
+
+func TestResourceServerDriftPolicy(t *testing.T) {
+	env := newTestEnv(t, resourceServer("api", func(spec *v1.A0ResourceServerSpec) {
+		spec.DriftPolicy = v1.DriftPolicyReport
+		spec.IgnoreFields = []string{"token_lifetime"}
+		spec.Conf.TokenLifetime = int32Ptr(3600)
+	}))
+	r := env.resourceServerReconciler()
+	ctx := context.Background()
+
+	if _, err := r.Reconcile(ctx, request("api")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	rs := &v1.A0ResourceServer{}
+	env.get(t, "api", rs)
+	id := rs.GetAuth0ID()
+
+	// edits in the Auth0 dashboard
+	env.auth0.Seed(fake.ResourceServers, fake.Object{"id": id, "identifier": audience, "name": "Dashboard name", "token_lifetime": float64(7200)})
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(ctx, request("api")); err != nil {
+			t.Fatalf("Reconcile failed: %v", err)
+		}
+		live, _ := env.auth0.Get(fake.ResourceServers, id)
+		if live["name"] != "Dashboard name" || live["token_lifetime"] != float64(7200) {
+			t.Fatalf("expected the changes in Auth0 to be left alone, got %v", live)
+		}
+		env.get(t, "api", rs)
+		if c := v1.GetCondition(rs, v1.ConditionTypeDrifted); c == nil || c.Status != metav1.ConditionTrue || c.Message != "fields changed in Auth0: name" {
+			t.Fatalf("reconcile %d: expected the drifted name to be reported, got %v", i+1, c)
+		}
+
+		// lastConf keeps the live state, while the drift baseline keeps the name it drifted from
+		last, err := rs.LastAppliedConf()
+		if err != nil || last == nil || *last.Name != "Dashboard name" {
+			t.Fatalf("reconcile %d: expected lastConf to hold the live name, got %v, %v", i+1, last, err)
+		}
+		var baseline map[string]interface{}
+		if rs.Status.DriftBaseline == nil || json.Unmarshal(rs.Status.DriftBaseline.Raw, &baseline) != nil || baseline["name"] != "Example API" {
+			t.Fatalf("reconcile %d: expected the drift baseline to hold the last applied name, got %v", i+1, rs.Status.DriftBaseline)
+		}
+	}
+
+	// a change of the configuration still wins
+	rs.Spec.Conf.Name = strPtr("New name")
+	if err := env.kube.Update(ctx, rs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(ctx, request("api")); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	env.get(t, "api", rs)
+	if live, _ := env.auth0.Get(fake.ResourceServers, id); live["name"] != "New name" || live["token_lifetime"] != float64(7200) {
+		t.Fatalf("expected the new name to be applied, got %v", live)
+	}
+	if c := v1.GetCondition(rs, v1.ConditionTypeDrifted); c == nil || c.Status != metav1.ConditionFalse {
+		t.Fatalf("expected no drift after the update, got %v", c)
+	}
+	if rs.Status.DriftBaseline != nil {
+		t.Fatalf("expected the drift baseline to be cleared, got %s", rs.Status.DriftBaseline.Raw)
+	}
+}
@@ -0,0 +1,458 @@
+// Package controller implements controller-runtime reconcilers for the A0* resources.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/ratelimit"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+	"github.com/seatgeek/auth0-operator/pkg/plan"
+)
+
+const (
+	// DefaultReconcileInterval is how often entities are reconciled with Auth0 when nothing changes
+	DefaultReconcileInterval = time.Hour
+
+	// dependencyRequeueInterval is how long to wait for a referenced resource to become available
+	dependencyRequeueInterval = 30 * time.Second
+
+	// rateLimitRequeueFloor is the shortest delay before retrying an entity that hit the Auth0 rate limit
+	rateLimitRequeueFloor = time.Minute
+)
+
+// ClientProvider returns the Management API client of a tenant. It is implemented by management.Cache.
+type ClientProvider interface {
+	ClientFor(ctx context.Context, tenant *v1.A0Tenant) (*management.Client, error)
+}
+
+var _ ClientProvider = &management.Cache{}
+
+// tenantEntity is a TenantEntity that can be read and written with a controller-runtime client
+type tenantEntity interface {
+	v1.TenantEntity
+	client.Object
+}
+
+// reconcileError is an error that carries the condition reason to report and how to retry.
+type reconcileError struct {
+	reason string
+	err    error
+
+	// requeueAfter retries the entity after the given delay instead of with exponential backoff
+	requeueAfter time.Duration
+
+	// terminal errors are not retried until the entity changes
+	terminal bool
+}
+
+func (e *reconcileError) Error() string { return e.err.Error() }
+
+func (e *reconcileError) Unwrap() error { return e.err }
+
+// terminalError reports a problem with the entity that cannot be solved by retrying.
+func terminalError(reason, format string, args ...interface{}) error {
+	return &reconcileError{reason: reason, err: fmt.Errorf(format, args...), terminal: true}
+}
+
+// dependencyError reports a referenced resource that is missing or not ready yet.
+func dependencyError(reason, format string, args ...interface{}) error {
+	return &reconcileError{reason: reason, err: fmt.Errorf(format, args...), requeueAfter: dependencyRequeueInterval}
+}
+
+// resolveTenant returns the A0Tenant referenced by entity, defaulting to the namespace of the entity.
+func resolveTenant(ctx context.Context, c client.Reader, entity tenantEntity) (*v1.A0Tenant, error) {
+	ref := entity.GetTenantRef()
+	if ref == nil || ref.Name == "" {
+		return nil, terminalError(v1.ReasonInvalidConfiguration, "%s/%s is missing a tenant reference", entity.GetNamespace(), entity.GetName())
+	}
+
+	key := types.NamespacedName{Namespace: entity.GetNamespace(), Name: ref.Name}
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		key.Namespace = *ref.Namespace
+	}
+
+	tenant := &v1.A0Tenant{}
+	if err := c.Get(ctx, key, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, dependencyError(v1.ReasonTenantNotFound, "tenant reference %s cannot be resolved", key)
+		}
+		return nil, err
+	}
+	return tenant, nil
+}
+
+// apiClientFor returns the Management API client of the tenant referenced by entity.
+func apiClientFor(ctx context.Context, c client.Reader, clients ClientProvider, entity tenantEntity) (*management.Client, error) {
+	tenant, err := resolveTenant(ctx, c, entity)
+	if err != nil {
+		return nil, err
+	}
+	api, err := clients.ClientFor(ctx, tenant)
+	if err != nil {
+		return nil, dependencyError(v1.ReasonTenantNotFound, "tenant %s/%s has no usable credentials: %v", tenant.Namespace, tenant.Name, err)
+	}
+	return api, nil
+}
+
+// deleteFromTenant deletes entity from Auth0 with del when it has an Auth0 ID, is in Apply mode and its policy
+// includes Delete. Entities whose tenant can no longer be resolved are left in Auth0, since there is no way to
+// reach them.
+func deleteFromTenant(ctx context.Context, c client.Reader, clients ClientProvider, entity tenantEntity, del func(api *management.Client, id string) error) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	id := entity.GetAuth0ID()
+	if id == "" {
+		return nil
+	}
+	if mode := entity.GetMode(); mode != v1.ReconcileModeApply {
+		log.Info("entity is not in Apply mode, leaving it in Auth0", "id", id, "mode", mode)
+		return nil
+	}
+	if !v1.HasPolicy(entity, v1.PolicyTypeDelete) {
+		log.Info("policy does not include Delete, leaving entity in Auth0", "id", id)
+		return nil
+	}
+
+	api, err := apiClientFor(ctx, c, clients, entity)
+	if err != nil {
+		var rerr *reconcileError
+		if errors.As(err, &rerr) {
+			log.Error(err, "cannot reach tenant, leaving entity in Auth0", "id", id)
+			return nil
+		}
+		return err
+	}
+
+	if err := del(api, id); err != nil && !management.IsNotFound(err) {
+		return err
+	}
+	log.Info("deleted entity from Auth0", "id", id)
+	return nil
+}
+
+// finalizeEntity deletes entity from Auth0 with del when its policy allows it, and then releases finalizer.
+func finalizeEntity(ctx context.Context, c client.Client, clients ClientProvider, entity tenantEntity, finalizer string, del func(api *management.Client, id string) error) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(entity, finalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := deleteFromTenant(ctx, c, clients, entity, del); err != nil {
+		if management.IsRateLimited(err) {
+			return ctrl.Result{RequeueAfter: rateLimitRequeueDelay(err)}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFrom(entity.DeepCopyObject().(client.Object))
+	controllerutil.RemoveFinalizer(entity, finalizer)
+	return ctrl.Result{}, c.Patch(ctx, entity, patch)
+}
+
+// checkCreatePolicy returns a terminal error when the policy of entity does not include Create.
+func checkCreatePolicy(kind string, entity tenantEntity) error {
+	if !v1.HasPolicy(entity, v1.PolicyTypeCreate) {
+		return terminalError(v1.ReasonPolicyDenied, "%s %s/%s does not support creation", kind, entity.GetNamespace(), entity.GetName())
+	}
+	return nil
+}
+
+// planCreate records in the status of entity that Apply mode would create it from init, one of the configuration
+// types of pkg/drift, and then update it to conf when the policy includes Update.
+func planCreate(entity tenantEntity, init, conf interface{}) error {
+	fields, err := drift.Compare(init, management.Object{})
+	if err != nil {
+		return err
+	}
+	if v1.HasPolicy(entity, v1.PolicyTypeUpdate) && !reflect.DeepEqual(init, conf) {
+		created, err := drift.ToObject(init)
+		if err != nil {
+			return err
+		}
+		updated, err := drift.Compare(conf, created)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, updated...)
+	}
+	entity.SetPlan(plan.Stamp(plan.ToStatus(v1.PlanActionCreate, fields), entity))
+	return nil
+}
+
+// planUpdate records in the status of entity the fields of live that Apply mode would change to match conf, leaving
+// out the unmanaged fields, see unmanagedFields.
+func planUpdate(entity tenantEntity, conf interface{}, live management.Object, unmanaged []string) error {
+	if !v1.HasPolicy(entity, v1.PolicyTypeUpdate) {
+		p := plan.ToStatus(v1.PlanActionNone, nil)
+		p.Message = "the policy does not include Update"
+		entity.SetPlan(plan.Stamp(p, entity))
+		return nil
+	}
+
+	fields, err := drift.CompareIgnoring(conf, live, unmanaged)
+	if err != nil {
+		return err
+	}
+	action := v1.PlanActionNone
+	if len(fields) > 0 {
+		action = v1.PlanActionUpdate
+	}
+	entity.SetPlan(plan.Stamp(plan.ToStatus(action, fields), entity))
+	return nil
+}
+
+// driftedFields returns the paths of the fields of conf that changed in Auth0 since the configuration was last
+// applied, which the operator leaves as they are unless the drift policy of entity is Correct. With the Report policy
+// they are also reported in the Drifted condition. Fields in the ignoreFields of entity are never drifted.
+func driftedFields(entity tenantEntity, conf interface{}, live management.Object) ([]string, error) {
+	policy := entity.GetDriftPolicy()
+	if policy == v1.DriftPolicyCorrect {
+		v1.RemoveCondition(entity, v1.ConditionTypeDrifted)
+		return nil, nil
+	}
+
+	changes, err := drift.ThreeWayIgnoring(conf, driftBaselineOf(entity), live, entity.GetIgnoreFields())
+	if err != nil {
+		return nil, err
+	}
+	var drifted []string
+	for _, c := range changes {
+		if c.Origin == drift.OutOfBand {
+			drifted = append(drifted, c.Path)
+		}
+	}
+
+	switch {
+	case policy != v1.DriftPolicyReport:
+		v1.RemoveCondition(entity, v1.ConditionTypeDrifted)
+	case len(drifted) == 0:
+		v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeDrifted, Status: metav1.ConditionFalse, Reason: v1.ReasonNoDrift, Message: "entity matches the last applied configuration"})
+	default:
+		v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeDrifted, Status: metav1.ConditionTrue, Reason: v1.ReasonDriftDetected, Message: "fields changed in Auth0: " + strings.Join(drifted, ", ")})
+	}
+	return drifted, nil
+}
+
+// unmanagedFields returns the paths of the fields an update of entity leaves out: its ignoreFields and the drifted
+// fields.
+func unmanagedFields(entity tenantEntity, drifted []string) []string {
+	return append(append([]string(nil), entity.GetIgnoreFields()...), drifted...)
+}
+
+// recordLastConf records live as the Status.LastConf of entity. While fields have drifted, their last applied values
+// are kept in Status.DriftBaseline, so that the next reconciliation still tells them apart from changes of the
+// configuration.
+func recordLastConf(entity tenantEntity, live management.Object, drifted []string) error {
+	lastConf, err := toRawExtension(live)
+	if err != nil {
+		return err
+	}
+
+	var baseline *runtime.RawExtension
+	if len(drifted) > 0 {
+		var last management.Object
+		if raw := driftBaselineOf(entity); raw != nil && len(raw.Raw) > 0 {
+			if err := json.Unmarshal(raw.Raw, &last); err != nil {
+				return fmt.Errorf("invalid drift baseline: %w", err)
+			}
+		}
+		copied, err := drift.ToObject(live)
+		if err != nil {
+			return err
+		}
+		drift.CopyFields(copied, last, drifted)
+		if baseline, err = toRawExtension(copied); err != nil {
+			return err
+		}
+	}
+
+	entity.SetLastConf(lastConf)
+	entity.SetDriftBaseline(baseline)
+	return nil
+}
+
+// resetLastConf clears the last applied configuration of entity, e.g. after its Auth0 entity was deleted.
+func resetLastConf(entity tenantEntity) {
+	entity.SetLastConf(nil)
+	entity.SetDriftBaseline(nil)
+}
+
+// driftBaselineOf returns the configuration that live is compared against to tell drift in Auth0 apart from changes of
+// the configuration: the drift baseline of entity while fields have drifted, and its LastConf otherwise.
+func driftBaselineOf(entity tenantEntity) *runtime.RawExtension {
+	if baseline := entity.GetDriftBaseline(); baseline != nil && len(baseline.Raw) > 0 {
+		return baseline
+	}
+	return entity.GetLastConf()
+}
+
+// missingEntityError reports that the Auth0 entity recorded in the status of entity no longer exists. The caller
+// resets the status, so that the next attempt finds or creates the entity again.
+func missingEntityError(kind string, entity tenantEntity, id string) error {
+	return &reconcileError{
+		reason:       v1.ReasonReconcileFailed,
+		err:          fmt.Errorf("%s %s/%s has missing API object %s, invalidating", kind, entity.GetNamespace(), entity.GetName(), id),
+		requeueAfter: time.Second,
+	}
+}
+
+// entityChangedPredicate triggers reconciliation on spec changes, deletion and moves between partitions, but not on
+// status updates.
+func entityChangedPredicate() predicate.Predicate {
+	return predicate.Or(predicate.GenerationChangedPredicate{}, predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !e.ObjectNew.GetDeletionTimestamp().IsZero() ||
+				e.ObjectOld.GetAnnotations()[v1.PartitionAnnotationKey] != e.ObjectNew.GetAnnotations()[v1.PartitionAnnotationKey]
+		},
+	})
+}
+
+// partitionPredicate only admits objects in partition, see v1.InPartition.
+func partitionPredicate(partition string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return v1.InPartition(obj, partition)
+	})
+}
+
+// finishReconcile records the outcome of a reconciliation in the status of entity and decides when to reconcile again.
+func finishReconcile(ctx context.Context, c client.Client, entity tenantEntity, err error, interval time.Duration) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	entity.SetObservedGeneration(entity.GetGeneration())
+
+	mode := entity.GetMode()
+	if mode != v1.ReconcileModePlan {
+		entity.SetPlan(nil)
+	}
+
+	result, retErr := ctrl.Result{RequeueAfter: interval}, error(nil)
+	if err == nil {
+		now := metav1.Now()
+		entity.SetLastSyncTime(&now)
+		switch mode {
+		case v1.ReconcileModePlan:
+			v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeSynced, Status: metav1.ConditionTrue, Reason: v1.ReasonPlanned})
+			v1.SetCondition(entity, planReadyCondition(entity.GetPlan()))
+		case v1.ReconcileModeObserve:
+			v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeSynced, Status: metav1.ConditionTrue, Reason: v1.ReasonObserved})
+			if entity.GetAuth0ID() == "" {
+				v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionFalse, Reason: v1.ReasonObserved, Message: "entity was not found in Auth0"})
+			} else {
+				v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: v1.ReasonObserved, Message: "entity exists in Auth0 and is not changed in Observe mode"})
+			}
+		default:
+			v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeSynced, Status: metav1.ConditionTrue, Reason: v1.ReasonReconciled})
+			v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: v1.ReasonReconciled, Message: "entity is in sync with Auth0"})
+		}
+	} else {
+		reason := v1.ReasonReconcileFailed
+		var rerr *reconcileError
+		switch {
+		case errors.As(err, &rerr):
+			reason = rerr.reason
+			switch {
+			case rerr.terminal:
+				result = ctrl.Result{}
+			case rerr.requeueAfter > 0:
+				result = ctrl.Result{RequeueAfter: rerr.requeueAfter}
+			default:
+				result, retErr = ctrl.Result{}, err
+			}
+		case management.IsRateLimited(err):
+			reason = v1.ReasonRateLimited
+			result = ctrl.Result{RequeueAfter: rateLimitRequeueDelay(err)}
+		default:
+			result, retErr = ctrl.Result{}, err
+		}
+
+		log.Error(err, "reconciliation failed", "reason", reason)
+		v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeSynced, Status: metav1.ConditionFalse, Reason: reason, Message: err.Error()})
+		v1.SetCondition(entity, metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionFalse, Reason: reason, Message: err.Error()})
+	}
+
+	if err := c.Status().Update(ctx, entity); err != nil {
+		return ctrl.Result{}, err
+	}
+	return result, retErr
+}
+
+// planReadyCondition returns the Ready condition of an entity in Plan mode, which is only ready when applying its
+// configuration would not change anything.
+func planReadyCondition(p *v1.V1Plan) metav1.Condition {
+	condition := metav1.Condition{Type: v1.ConditionTypeReady, Status: metav1.ConditionTrue, Reason: v1.ReasonPlanned, Message: "entity is in sync with Auth0"}
+	switch {
+	case p == nil:
+	case p.Action == v1.PlanActionCreate:
+		condition.Status, condition.Message = metav1.ConditionFalse, "entity would be created; set spec.mode to Apply to create it"
+	case p.Pending():
+		condition.Status, condition.Message = metav1.ConditionFalse, fmt.Sprintf("%d pending changes; set spec.mode to Apply to apply them", len(p.Changes))
+	case p.Message != "":
+		condition.Message = p.Message
+	}
+	return condition
+}
+
+// rateLimitRequeueDelay returns when to retry after a 429, from the x-ratelimit-reset hint of the response
+// with up to 20% of upward jitter, but never sooner than rateLimitRequeueFloor.
+func rateLimitRequeueDelay(err error) time.Duration {
+	delay := rateLimitRequeueFloor
+	var apiErr *management.Error
+	if errors.As(err, &apiErr) && apiErr.Header != nil {
+		if reset, perr := strconv.ParseInt(apiErr.Header.Get(ratelimit.HeaderReset), 10, 64); perr == nil {
+			delay = time.Until(time.Unix(reset, 0))
+		}
+	}
+	delay = time.Duration(float64(delay) * (1 + rand.Float64()*0.2))
+	if delay < rateLimitRequeueFloor {
+		return rateLimitRequeueFloor
+	}
+	return delay
+}
+
+// toRawExtension converts a Management API entity to the representation stored in Status.LastConf.
+func toRawExtension(obj management.Object) (*runtime.RawExtension, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: data}, nil
+}
+
+// withoutFields returns a copy of obj without the given top-level fields.
+func withoutFields(obj management.Object, fields ...string) management.Object {
+	out := make(management.Object, len(obj))
+	for k, v := range obj {
+		out[k] = v
+	}
+	for _, f := range fields {
+		delete(out, f)
+	}
+	return out
+}
+
+// differencePaths returns the paths of diffs, e.g. to log the fields an update changes.
+func differencePaths(diffs []drift.Difference) []string {
+	paths := make([]string, len(diffs))
+	for i, d := range diffs {
+		paths[i] = d.Path
+	}
+	return paths
+}
@@ -0,0 +1,235 @@
+package deploycli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+// Options control the generated resources
+type Options struct {
+	// Namespace is the namespace of the generated resources
+	Namespace string
+
+	// TenantName is the name of the generated A0Tenant, referenced by every other resource
+	TenantName string
+
+	// Domain is the domain of the tenant, written to the A0Tenant. Defaults to the domain of the config.
+	Domain string
+
+	// SecretName is the Secret with the Management API credentials of the tenant, written to the A0Tenant
+	SecretName string
+}
+
+// Conversion holds the resources generated from a deploy CLI export
+type Conversion struct {
+	Tenant          *v1.A0Tenant
+	Clients         []*v1.A0Client
+	Connections     []*v1.A0Connection
+	ResourceServers []*v1.A0ResourceServer
+	ClientGrants    []*v1.A0ClientGrant
+
+	// Warnings lists what could not be converted
+	Warnings []string
+
+	// keywords are the Conf fields whose values come from keywords
+	keywords []keywordField
+}
+
+// keywordField is a Conf field of a generated resource whose value comes from keywords
+type keywordField struct {
+	obj   client.Object
+	field string
+	value interface{}
+}
+
+// Objects returns the generated resources in the order they depend on each other.
+func (c *Conversion) Objects() []client.Object {
+	var objs []client.Object
+	if c.Tenant != nil {
+		objs = append(objs, c.Tenant)
+	}
+	for _, conn := range c.Connections {
+		objs = append(objs, conn)
+	}
+	for _, rs := range c.ResourceServers {
+		objs = append(objs, rs)
+	}
+	for _, cl := range c.Clients {
+		objs = append(objs, cl)
+	}
+	for _, g := range c.ClientGrants {
+		objs = append(objs, g)
+	}
+	return objs
+}
+
+// Convert returns the entities of a as A0* resources, with keywords replaced by the values of cfg, which may be nil.
+// References by name in the export, such as the enabled_clients of connections and the client_id of client grants,
+// become references to the generated resources.
+func Convert(a *Assets, cfg *Config, opts Options) (*Conversion, error) {
+	if opts.TenantName == "" {
+		return nil, fmt.Errorf("a tenant name is required")
+	}
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	c := &Conversion{Warnings: append([]string(nil), a.Warnings...)}
+	r := newReplacer(cfg.Mappings)
+	names := manifest.NewNamer()
+	tenantRef := &v1.V1TenantReference{Name: opts.TenantName}
+
+	domain := opts.Domain
+	if domain == "" {
+		domain = cfg.Domain
+	}
+	c.Tenant = &v1.A0Tenant{
+		TypeMeta:   typeMeta("A0Tenant"),
+		ObjectMeta: objectMeta(opts.TenantName, opts.Namespace),
+		Spec: v1.A0TenantSpec{
+			Name: opts.TenantName,
+			Auth: &v1.TenantAuth{Domain: &domain, SecretRef: &v1.V1SecretReference{Name: opts.SecretName, Namespace: opts.Namespace}},
+			Conf: &v1.TenantConf{},
+		},
+	}
+	c.convert("tenant", c.Tenant, a.Tenant, r, c.Tenant.Spec.Conf)
+
+	clients := map[string]*v1.A0Client{}
+	for _, raw := range a.Clients {
+		clientName, _ := r.replace(raw["name"]).(string)
+		obj := &v1.A0Client{
+			TypeMeta:   typeMeta("A0Client"),
+			ObjectMeta: objectMeta(names.Next("client", clientName, ""), opts.Namespace),
+			Spec:       v1.A0ClientSpec{TenantRef: tenantRef, Conf: &v1.ClientConf{}},
+		}
+		c.convert("client "+obj.Name, obj, raw, r, obj.Spec.Conf, "client_id", "client_secret", "signing_keys", "tenant", "global", "callback_url_template", "owners")
+		clients[clientName] = obj
+		c.Clients = append(c.Clients, obj)
+	}
+
+	for _, list := range []struct {
+		objs     []management.Object
+		strategy string
+	}{{a.Databases, "auth0"}, {a.Connections, ""}} {
+		for _, raw := range list.objs {
+			connectionName, _ := r.replace(raw["name"]).(string)
+			obj := &v1.A0Connection{
+				TypeMeta:   typeMeta("A0Connection"),
+				ObjectMeta: objectMeta(names.Next("connection", connectionName, ""), opts.Namespace),
+				Spec:       v1.A0ConnectionSpec{TenantRef: tenantRef, Conf: &v1.ConnectionConf{}},
+			}
+			c.convert("connection "+obj.Name, obj, raw, r, obj.Spec.Conf, "id", "enabled_clients")
+			if obj.Spec.Conf.Strategy == nil && list.strategy != "" {
+				obj.Spec.Conf.Strategy = &list.strategy
+			}
+			c.Connections = append(c.Connections, obj)
+
+			enabled, _ := r.replace(raw["enabled_clients"]).([]interface{})
+			for _, e := range enabled {
+				clientName, _ := e.(string)
+				a0client, ok := clients[clientName]
+				if !ok {
+					c.Warnings = append(c.Warnings, fmt.Sprintf("connection %s: enabled client %q is not in the export", obj.Name, clientName))
+					continue
+				}
+				name := obj.Name
+				a0client.Spec.Conf.EnabledConnections = append(a0client.Spec.Conf.EnabledConnections, v1.V1ConnectionReference{Name: &name})
+			}
+		}
+	}
+	for _, a0client := range c.Clients {
+		refs := a0client.Spec.Conf.EnabledConnections
+		sort.Slice(refs, func(i, j int) bool { return *refs[i].Name < *refs[j].Name })
+	}
+
+	audiences := map[string]string{}
+	for _, raw := range a.ResourceServers {
+		identifier, _ := r.replace(raw["identifier"]).(string)
+		obj := &v1.A0ResourceServer{
+			TypeMeta:   typeMeta("A0ResourceServer"),
+			ObjectMeta: objectMeta(names.Next("resourceserver", r.replace(raw["name"]), identifier), opts.Namespace),
+			Spec:       v1.A0ResourceServerSpec{TenantRef: tenantRef, Conf: &v1.ResourceServerConf{}},
+		}
+		c.convert("resource server "+obj.Name, obj, raw, r, obj.Spec.Conf, "id", "is_system")
+		audiences[strings.ToLower(identifier)] = obj.Name
+		c.ResourceServers = append(c.ResourceServers, obj)
+	}
+
+	for _, raw := range a.ClientGrants {
+		clientName, _ := r.replace(raw["client_id"]).(string)
+		audience, _ := r.replace(raw["audience"]).(string)
+		conf := &v1.ClientGrantConf{}
+
+		grantName := clientName
+		if a0client, ok := clients[clientName]; ok {
+			conf.ClientRef = &v1.V1ClientReference{Name: &a0client.Name}
+			grantName = a0client.Name
+		} else {
+			conf.ClientRef = &v1.V1ClientReference{Id: &clientName}
+			c.Warnings = append(c.Warnings, fmt.Sprintf("client grant %s %s: client %q is not in the export and is referenced as a client ID", clientName, audience, clientName))
+		}
+		if name, ok := audiences[strings.ToLower(audience)]; ok {
+			conf.Audience = &v1.V1ResourceServerReference{Name: &name}
+			grantName += "-" + name
+		} else {
+			conf.Audience = &v1.V1ResourceServerReference{Identifier: &audience}
+			grantName += "-" + audience
+		}
+
+		obj := &v1.A0ClientGrant{
+			TypeMeta:   typeMeta("A0ClientGrant"),
+			ObjectMeta: objectMeta(names.Next("clientgrant", grantName, ""), opts.Namespace),
+			Spec:       v1.A0ClientGrantSpec{TenantRef: tenantRef, Conf: conf},
+		}
+		c.convert("client grant "+obj.Name, obj, raw, r, conf, "client_id", "audience")
+		c.ClientGrants = append(c.ClientGrants, obj)
+	}
+
+	c.Warnings = append(c.Warnings, r.warnings()...)
+	return c, nil
+}
+
+// convert decodes the fields of raw other than skip into conf, the Conf of obj, one at a time, so that a field the
+// A0* types cannot represent is reported as a warning instead of failing the conversion.
+func (c *Conversion) convert(what string, obj client.Object, raw management.Object, r *replacer, conf interface{}, skip ...string) {
+	skipped := map[string]bool{}
+	for _, f := range skip {
+		skipped[f] = true
+	}
+
+	for _, key := range sortedKeys(raw) {
+		if skipped[key] {
+			if hasKeyword(raw[key]) {
+				c.Warnings = append(c.Warnings, fmt.Sprintf("%s: %s uses keywords, which overlays cannot change", what, key))
+			}
+			continue
+		}
+		data, err := json.Marshal(map[string]interface{}{key: r.replace(raw[key])})
+		if err == nil {
+			err = json.Unmarshal(data, conf)
+		}
+		if err != nil {
+			c.Warnings = append(c.Warnings, fmt.Sprintf("%s: %s was not converted: %v", what, key, err))
+			continue
+		}
+		if hasKeyword(raw[key]) {
+			c.keywords = append(c.keywords, keywordField{obj: obj, field: key, value: raw[key]})
+		}
+	}
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: v1.GroupVersion.String(), Kind: kind}
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: namespace}
+}
@@ -0,0 +1,184 @@
+// Package deploycli converts between the formats of auth0-deploy-cli (a0deploy) and A0* resources, so that tenants
+// configured with the deploy CLI can move to the operator, or back, one entity at a time.
+//
+// Both deploy CLI formats are supported: the YAML format, a single tenant.yaml file, and the directory format, with
+// a JSON file per entity in the clients, connections, database-connections, resource-servers and grants
+// directories. Keyword replacement works as in the deploy CLI: @@KEY@@ is replaced with the value of KEY as JSON and
+// ##KEY## with the value of KEY as a string.
+package deploycli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+// Assets are the entities of a deploy CLI export that have an A0* kind
+type Assets struct {
+	Tenant          management.Object   `json:"tenant,omitempty"`
+	Clients         []management.Object `json:"clients,omitempty"`
+	Databases       []management.Object `json:"databases,omitempty"`
+	Connections     []management.Object `json:"connections,omitempty"`
+	ResourceServers []management.Object `json:"resourceServers,omitempty"`
+	ClientGrants    []management.Object `json:"clientGrants,omitempty"`
+
+	// Warnings lists what was left out, such as sections of the export without an A0* kind
+	Warnings []string `json:"-"`
+}
+
+// Config holds the settings of a deploy CLI config file that differ between environments
+type Config struct {
+	// Domain is the AUTH0_DOMAIN of the config
+	Domain string `json:"AUTH0_DOMAIN,omitempty"`
+
+	// Mappings is the AUTH0_KEYWORD_REPLACE_MAPPINGS of the config
+	Mappings map[string]interface{} `json:"AUTH0_KEYWORD_REPLACE_MAPPINGS,omitempty"`
+}
+
+// ReadConfig reads a deploy CLI config file such as config.json.
+func ReadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+var (
+	jsonKeyword   = regexp.MustCompile(`@@(\w+)@@`)
+	stringKeyword = regexp.MustCompile(`##(\w+)##`)
+	wholeKeyword  = regexp.MustCompile(`^@@(\w+)@@$`)
+)
+
+// quoteKeywords turns each @@KEY@@ of a YAML or JSON document into a string, so that the document parses before the
+// keywords are replaced.
+func quoteKeywords(data []byte) []byte {
+	return jsonKeyword.ReplaceAll(data, []byte(`"@@$1@@"`))
+}
+
+// hasKeyword reports whether v contains a keyword anywhere.
+func hasKeyword(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return jsonKeyword.MatchString(v) || stringKeyword.MatchString(v)
+	case map[string]interface{}:
+		for _, e := range v {
+			if hasKeyword(e) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if hasKeyword(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// replacer replaces keywords with the values of mappings and remembers the keywords without a value
+type replacer struct {
+	mappings map[string]interface{}
+	missing  map[string]bool
+}
+
+func newReplacer(mappings map[string]interface{}) *replacer {
+	return &replacer{mappings: mappings, missing: map[string]bool{}}
+}
+
+// replace returns a copy of v with its keywords replaced. A string that is a single @@KEY@@ becomes the value of
+// KEY, which need not be a string. Keywords without a value are left as they are.
+func (r *replacer) replace(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		if m := wholeKeyword.FindStringSubmatch(v); m != nil {
+			if value, ok := r.lookup(m[1]); ok {
+				return value
+			}
+			return v
+		}
+		v = jsonKeyword.ReplaceAllStringFunc(v, func(s string) string {
+			value, ok := r.lookup(s[2 : len(s)-2])
+			if !ok {
+				return s
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				return s
+			}
+			return string(data)
+		})
+		return stringKeyword.ReplaceAllStringFunc(v, func(s string) string {
+			value, ok := r.lookup(s[2 : len(s)-2])
+			if !ok {
+				return s
+			}
+			return fmt.Sprint(value)
+		})
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[k] = r.replace(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = r.replace(e)
+		}
+		return out
+	}
+	return v
+}
+
+func (r *replacer) lookup(key string) (interface{}, bool) {
+	value, ok := r.mappings[key]
+	if !ok {
+		r.missing[key] = true
+	}
+	return value, ok
+}
+
+// warnings returns a warning for each keyword without a value.
+func (r *replacer) warnings() []string {
+	var warnings []string
+	for _, key := range sortedKeys(r.missing) {
+		warnings = append(warnings, fmt.Sprintf("no value for keyword %s", key))
+	}
+	return warnings
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// entityName returns the name of a deploy CLI entity, which identifies it in the deploy CLI.
+func entityName(obj management.Object) string {
+	name, _ := obj["name"].(string)
+	return name
+}
+
+// fileName returns name with the characters that are not allowed in file names replaced, as the deploy CLI does.
+func fileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\?%*:|"<>`, r) {
+			return '-'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,260 @@
+package deploycli_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/seatgeek/auth0-operator/pkg/deploycli"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+const tenantYAML = `tenant:
+  friendly_name: '##COMPANY## Dev'
+  enabled_locales: [en]
+clients:
+  - name: Web
+    app_type: regular_web
+    callbacks: @@WEB_CALLBACKS@@
+    allowed_logout_urls: ['https://##WEB_HOST##/logout']
+  - name: Worker
+    app_type: non_interactive
+databases:
+  - name: Username-Password-Authentication
+    enabled_clients: [Web, Missing]
+    options:
+      customScripts:
+        login: ./databases/Username-Password-Authentication/login.js
+connections:
+  - name: google-oauth2
+    strategy: google-oauth2
+    enabled_clients: [Web]
+resourceServers:
+  - name: Things API
+    identifier: https://api.example.com
+    scopes:
+      - value: read:things
+clientGrants:
+  - client_id: Worker
+    audience: https://api.example.com
+    scope: [read:things]
+  - client_id: Worker
+    audience: https://dev.eu.auth0.com/api/v2/
+    scope: [read:users]
+rules:
+  - name: legacy
+`
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func convertYAML(t *testing.T) *deploycli.Conversion {
+	t.Helper()
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"tenant.yaml": tenantYAML,
+		"databases/Username-Password-Authentication/login.js": "function login() { return '##WEB_HOST##'; }",
+	})
+
+	assets, err := deploycli.Load(filepath.Join(dir, "tenant.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &deploycli.Config{Domain: "dev.eu.auth0.com", Mappings: map[string]interface{}{
+		"COMPANY":       "Example",
+		"WEB_HOST":      "dev.example.com",
+		"WEB_CALLBACKS": []interface{}{"https://dev.example.com/cb"},
+	}}
+	c, err := deploycli.Convert(assets, cfg, deploycli.Options{Namespace: "auth0", TenantName: "dev", SecretName: "dev-auth0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestConvert(t *testing.T) {
+	c := convertYAML(t)
+
+	if got := *c.Tenant.Spec.Conf.FriendlyName; got != "Example Dev" {
+		t.Errorf("expected ##COMPANY## to be replaced, got friendly_name %q", got)
+	}
+	if got := *c.Tenant.Spec.Auth.Domain; got != "dev.eu.auth0.com" {
+		t.Errorf("expected the domain of the config, got %q", got)
+	}
+
+	if len(c.Clients) != 2 {
+		t.Fatalf("expected 2 clients, got %d", len(c.Clients))
+	}
+	web := c.Clients[0]
+	if web.Name != "web" || web.Spec.TenantRef.Name != "dev" || web.Spec.Find != nil {
+		t.Errorf("unexpected client %s", web.Name)
+	}
+	if got := web.Spec.Conf.Callbacks; !reflect.DeepEqual(got, []string{"https://dev.example.com/cb"}) {
+		t.Errorf("expected @@WEB_CALLBACKS@@ to be replaced with a list, got %v", got)
+	}
+	var enabled []string
+	for _, ref := range web.Spec.Conf.EnabledConnections {
+		enabled = append(enabled, *ref.Name)
+	}
+	if want := []string{"google-oauth2", "username-password-authentication"}; !reflect.DeepEqual(enabled, want) {
+		t.Errorf("expected the enabled_clients of connections on the client, got %v", enabled)
+	}
+
+	db := c.Connections[0]
+	if *db.Spec.Conf.Strategy != "auth0" || !strings.Contains(string(db.Spec.Conf.Options.Raw), `function login() { return 'dev.example.com'; }`) {
+		t.Errorf("expected a database with its script, got strategy %s and options %s", *db.Spec.Conf.Strategy, db.Spec.Conf.Options.Raw)
+	}
+
+	if len(c.ClientGrants) != 2 {
+		t.Fatalf("expected 2 client grants, got %d", len(c.ClientGrants))
+	}
+	grant := c.ClientGrants[0]
+	if grant.Name != "worker-things-api" || *grant.Spec.Conf.ClientRef.Name != "worker" || *grant.Spec.Conf.Audience.Name != "things-api" {
+		t.Errorf("expected the grant to reference the generated resources, got %s", grant.Name)
+	}
+	if system := c.ClientGrants[1].Spec.Conf.Audience; system.Identifier == nil || *system.Identifier != "https://dev.eu.auth0.com/api/v2/" {
+		t.Errorf("expected an audience that is not exported to be kept as an identifier, got %+v", system)
+	}
+
+	warnings := strings.Join(c.Warnings, "\n")
+	for _, want := range []string{"rules has no A0* kind", `enabled client "Missing" is not in the export`} {
+		if !strings.Contains(warnings, want) {
+			t.Errorf("expected a warning containing %q, got:\n%s", want, warnings)
+		}
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	c := convertYAML(t)
+
+	patches, warnings := c.Overlay(&deploycli.Config{Domain: "prod.eu.auth0.com", Mappings: map[string]interface{}{
+		"COMPANY":       "Example",
+		"WEB_CALLBACKS": []interface{}{"https://example.com/cb", "https://www.example.com/cb"},
+	}})
+	if !reflect.DeepEqual(warnings, []string{"no value for keyword WEB_HOST"}) {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+
+	var files []string
+	for _, p := range patches {
+		files = append(files, manifest.FileName(p))
+	}
+	if want := []string{"a0tenant-dev.yaml", "a0connection-username-password-authentication.yaml", "a0client-web.yaml"}; !reflect.DeepEqual(files, want) {
+		t.Fatalf("expected patches for the resources with keywords, got %v", files)
+	}
+
+	dir := t.TempDir()
+	if err := deploycli.WriteOverlay(dir, "../../base", patches); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "a0client-web.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata:
+  name: web
+  namespace: auth0
+spec:
+  conf:
+    allowed_logout_urls:
+    - https://##WEB_HOST##/logout
+    callbacks:
+    - https://example.com/cb
+    - https://www.example.com/cb
+`
+	if string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+
+	data, err = os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "resources:\n- ../../base\n") || !strings.Contains(string(data), "- path: a0tenant-dev.yaml\n") {
+		t.Errorf("unexpected kustomization.yaml:\n%s", data)
+	}
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	c := convertYAML(t)
+
+	assets, err := deploycli.Export(c.Objects())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assets.Warnings) != 0 {
+		t.Errorf("unexpected warnings %v", assets.Warnings)
+	}
+	if len(assets.Databases) != 1 || len(assets.Connections) != 1 {
+		t.Fatalf("expected the auth0 connection to be a database, got %d databases and %d connections", len(assets.Databases), len(assets.Connections))
+	}
+	if got := assets.Databases[0]["enabled_clients"]; !reflect.DeepEqual(got, []interface{}{"Web"}) {
+		t.Errorf("expected enabled_clients by client name, got %v", got)
+	}
+	if got := assets.ClientGrants[0]; got["client_id"] != "Worker" || got["audience"] != "https://api.example.com" {
+		t.Errorf("expected the grant to use the client name and the audience, got %v", got)
+	}
+
+	dir := t.TempDir()
+	if err := deploycli.WriteDirectory(dir, assets); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{
+		"tenant.json",
+		"clients/Web.json",
+		"connections/google-oauth2.json",
+		"database-connections/Username-Password-Authentication/database.json",
+		"database-connections/Username-Password-Authentication/login.js",
+		"resource-servers/Things API.json",
+		"grants/Worker-https---api.example.com.json",
+	} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("expected %s: %v", file, err)
+		}
+	}
+
+	loaded, err := deploycli.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := deploycli.Convert(loaded, nil, deploycli.Options{Namespace: "auth0", TenantName: "dev", SecretName: "dev-auth0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Warnings) != 0 {
+		t.Errorf("unexpected warnings %v", again.Warnings)
+	}
+	*again.Tenant.Spec.Auth.Domain = *c.Tenant.Spec.Auth.Domain
+	if got, want := names(again.Objects()), names(c.Objects()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected the same resources after a round trip, got %v, want %v", got, want)
+	}
+	for i, obj := range again.Objects() {
+		if want := c.Objects()[i]; !reflect.DeepEqual(obj, want) {
+			t.Errorf("%s changed in the round trip:\n%+v\n%+v", manifest.FileName(obj), obj, want)
+		}
+	}
+}
+
+func names(objs []client.Object) []string {
+	var out []string
+	for _, obj := range objs {
+		out = append(out, manifest.FileName(obj))
+	}
+	return out
+}
@@ -0,0 +1,281 @@
+package deploycli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// Export returns the Conf of the A0* resources among objs in the deploy CLI format, the reverse of Convert. The
+// enabled connections of clients become the enabled_clients of connections, connections with the auth0 strategy
+// become databases, and references become the client names and audiences the deploy CLI uses. Other objects are
+// ignored.
+func Export(objs []client.Object) (*Assets, error) {
+	a := &Assets{}
+	clientNames := map[types.NamespacedName]string{}
+	connections := map[types.NamespacedName]management.Object{}
+	audiences := map[types.NamespacedName]string{}
+	var clients []*v1.A0Client
+	var grants []*v1.A0ClientGrant
+
+	for _, obj := range objs {
+		key := client.ObjectKeyFromObject(obj)
+		switch obj := obj.(type) {
+		case *v1.A0Tenant:
+			if a.Tenant != nil {
+				a.Warnings = append(a.Warnings, fmt.Sprintf("A0Tenant %s: only the first tenant is exported", key))
+				continue
+			}
+			conf, err := drift.ToObject(obj.Spec.Conf)
+			if err != nil {
+				return nil, fmt.Errorf("A0Tenant %s: %w", key, err)
+			}
+			a.Tenant = conf
+		case *v1.A0Client:
+			conf, err := drift.ToObject(obj.Spec.Conf)
+			if err != nil {
+				return nil, fmt.Errorf("A0Client %s: %w", key, err)
+			}
+			delete(conf, "enabled_connections")
+			if _, ok := conf["name"]; !ok {
+				conf["name"] = obj.Name
+			}
+			clientNames[key] = entityName(conf)
+			clients = append(clients, obj)
+			a.Clients = append(a.Clients, conf)
+		case *v1.A0Connection:
+			conf, err := drift.ToObject(obj.Spec.Conf)
+			if err != nil {
+				return nil, fmt.Errorf("A0Connection %s: %w", key, err)
+			}
+			if _, ok := conf["name"]; !ok {
+				conf["name"] = obj.Name
+			}
+			conf["enabled_clients"] = []interface{}{}
+			connections[key] = conf
+			if conf["strategy"] == "auth0" {
+				a.Databases = append(a.Databases, conf)
+			} else {
+				a.Connections = append(a.Connections, conf)
+			}
+		case *v1.A0ResourceServer:
+			conf, err := drift.ToObject(obj.Spec.Conf)
+			if err != nil {
+				return nil, fmt.Errorf("A0ResourceServer %s: %w", key, err)
+			}
+			delete(conf, "id")
+			if _, ok := conf["name"]; !ok {
+				conf["name"] = obj.Name
+			}
+			audiences[key], _ = conf["identifier"].(string)
+			a.ResourceServers = append(a.ResourceServers, conf)
+		case *v1.A0ClientGrant:
+			grants = append(grants, obj)
+		}
+	}
+
+	for _, c := range clients {
+		if c.Spec.Conf == nil {
+			continue
+		}
+		for _, ref := range c.Spec.Conf.EnabledConnections {
+			if ref.Name == nil {
+				a.Warnings = append(a.Warnings, fmt.Sprintf("A0Client %s: connection %s is referenced by ID, which the deploy CLI cannot express", client.ObjectKeyFromObject(c), deref(ref.Id)))
+				continue
+			}
+			conn, ok := connections[refKey(ref.Namespace, *ref.Name, c.Namespace)]
+			if !ok {
+				a.Warnings = append(a.Warnings, fmt.Sprintf("A0Client %s: connection %s is not exported", client.ObjectKeyFromObject(c), *ref.Name))
+				continue
+			}
+			conn["enabled_clients"] = append(conn["enabled_clients"].([]interface{}), clientNames[client.ObjectKeyFromObject(c)])
+		}
+	}
+
+	for _, g := range grants {
+		key := client.ObjectKeyFromObject(g)
+		conf := g.Spec.Conf
+		if conf == nil || conf.ClientRef == nil || conf.Audience == nil {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("A0ClientGrant %s: a client and an audience are required", key))
+			continue
+		}
+
+		var clientName, audience string
+		switch ref := conf.ClientRef; {
+		case ref.Name != nil:
+			clientName = clientNames[refKey(ref.Namespace, *ref.Name, g.Namespace)]
+		case ref.Id != nil:
+			clientName = *ref.Id
+		}
+		switch ref := conf.Audience; {
+		case ref.Name != nil:
+			audience = audiences[refKey(ref.Namespace, *ref.Name, g.Namespace)]
+		case ref.Identifier != nil:
+			audience = *ref.Identifier
+		}
+		if clientName == "" || audience == "" {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("A0ClientGrant %s: the client or the audience is not exported", key))
+			continue
+		}
+
+		grant := management.Object{"client_id": clientName, "audience": audience}
+		if conf.Scope != nil {
+			scope := make([]interface{}, len(conf.Scope))
+			for i, s := range conf.Scope {
+				scope[i] = s
+			}
+			grant["scope"] = scope
+		}
+		a.ClientGrants = append(a.ClientGrants, grant)
+	}
+
+	for _, list := range [][]management.Object{a.Clients, a.Databases, a.Connections, a.ResourceServers} {
+		sort.SliceStable(list, func(i, j int) bool { return entityName(list[i]) < entityName(list[j]) })
+	}
+	for _, conn := range connections {
+		enabled := conn["enabled_clients"].([]interface{})
+		sort.Slice(enabled, func(i, j int) bool { return enabled[i].(string) < enabled[j].(string) })
+	}
+	sort.SliceStable(a.ClientGrants, func(i, j int) bool { return grantFileName(a.ClientGrants[i]) < grantFileName(a.ClientGrants[j]) })
+	return a, nil
+}
+
+// WriteYAML writes a to dir in the YAML format: a tenant.yaml file, with the custom scripts of databases in
+// databases/<name>/<script>.js.
+func WriteYAML(dir string, a *Assets) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	out := *a
+	out.Databases = nil
+	for _, db := range a.Databases {
+		db, err := writeScripts(dir, filepath.Join("databases", fileName(entityName(db))), "./databases/"+fileName(entityName(db))+"/", db)
+		if err != nil {
+			return err
+		}
+		out.Databases = append(out.Databases, db)
+	}
+
+	data, err := yaml.Marshal(&out)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "tenant.yaml"), data, 0o644)
+}
+
+// WriteDirectory writes a to dir in the directory format.
+func WriteDirectory(dir string, a *Assets) error {
+	if a.Tenant != nil {
+		if err := writeJSON(filepath.Join(dir, "tenant.json"), a.Tenant); err != nil {
+			return err
+		}
+	}
+	for _, list := range []struct {
+		dir  string
+		objs []management.Object
+	}{{"clients", a.Clients}, {"connections", a.Connections}, {"resource-servers", a.ResourceServers}} {
+		for _, obj := range list.objs {
+			if err := writeJSON(filepath.Join(dir, list.dir, fileName(entityName(obj))+".json"), obj); err != nil {
+				return err
+			}
+		}
+	}
+	for _, db := range a.Databases {
+		dbDir := filepath.Join("database-connections", fileName(entityName(db)))
+		db, err := writeScripts(dir, dbDir, "./", db)
+		if err != nil {
+			return err
+		}
+		if err := writeJSON(filepath.Join(dir, dbDir, "database.json"), db); err != nil {
+			return err
+		}
+	}
+	for _, grant := range a.ClientGrants {
+		if err := writeJSON(filepath.Join(dir, "grants", grantFileName(grant)+".json"), grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeScripts writes the custom scripts of db to scriptDir, relative to dir, and returns a copy of db that refers
+// to them with prefix, as the deploy CLI expects.
+func writeScripts(dir, scriptDir, prefix string, db management.Object) (management.Object, error) {
+	options, _ := db["options"].(map[string]interface{})
+	scripts, _ := options["customScripts"].(map[string]interface{})
+	if len(scripts) == 0 {
+		return db, nil
+	}
+
+	refs := map[string]interface{}{}
+	for name, script := range scripts {
+		s, ok := script.(string)
+		if !ok {
+			refs[name] = script
+			continue
+		}
+		path := filepath.Join(dir, scriptDir, name+".js")
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, []byte(s), 0o644); err != nil {
+			return nil, err
+		}
+		refs[name] = prefix + name + ".js"
+	}
+
+	out := management.Object{}
+	for k, v := range db {
+		out[k] = v
+	}
+	outOptions := map[string]interface{}{}
+	for k, v := range options {
+		outOptions[k] = v
+	}
+	outOptions["customScripts"] = refs
+	out["options"] = outOptions
+	return out, nil
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// grantFileName names a client grant after its client and audience, as the deploy CLI does.
+func grantFileName(grant management.Object) string {
+	clientName, _ := grant["client_id"].(string)
+	audience, _ := grant["audience"].(string)
+	return fileName(clientName + "-" + audience)
+}
+
+// refKey returns the key of a reference by name, which defaults to the namespace of the referencing resource.
+func refKey(namespace *string, name, defaultNamespace string) types.NamespacedName {
+	if namespace != nil && *namespace != "" {
+		return types.NamespacedName{Namespace: *namespace, Name: name}
+	}
+	return types.NamespacedName{Namespace: defaultNamespace, Name: name}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,203 @@
+package deploycli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+// yamlSections maps the sections of tenant.yaml to the fields of Assets
+var yamlSections = map[string]func(a *Assets) *[]management.Object{
+	"clients":         func(a *Assets) *[]management.Object { return &a.Clients },
+	"databases":       func(a *Assets) *[]management.Object { return &a.Databases },
+	"connections":     func(a *Assets) *[]management.Object { return &a.Connections },
+	"resourceServers": func(a *Assets) *[]management.Object { return &a.ResourceServers },
+	"clientGrants":    func(a *Assets) *[]management.Object { return &a.ClientGrants },
+}
+
+// directories maps the directories of the directory format to the fields of Assets
+var directories = map[string]func(a *Assets) *[]management.Object{
+	"clients":          func(a *Assets) *[]management.Object { return &a.Clients },
+	"connections":      func(a *Assets) *[]management.Object { return &a.Connections },
+	"resource-servers": func(a *Assets) *[]management.Object { return &a.ResourceServers },
+	"grants":           func(a *Assets) *[]management.Object { return &a.ClientGrants },
+}
+
+// Load reads a deploy CLI export: a tenant.yaml file, or a directory in the directory format. Keywords are kept as
+// they are and replaced by Convert. The custom scripts of database connections are read from the files they refer to.
+func Load(path string) (*Assets, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return loadDirectory(path)
+	}
+	return loadYAML(path)
+}
+
+func loadYAML(path string) (*Assets, error) {
+	doc := map[string]interface{}{}
+	if err := readDocument(path, &doc); err != nil {
+		return nil, err
+	}
+
+	a := &Assets{}
+	for _, section := range sortedKeys(doc) {
+		value := doc[section]
+		if section == "tenant" {
+			tenant, err := asObject(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: tenant: %w", path, err)
+			}
+			a.Tenant = tenant
+			continue
+		}
+		field, ok := yamlSections[section]
+		if !ok {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("%s: %s has no A0* kind and was not converted", path, section))
+			continue
+		}
+		list, ok := value.([]interface{})
+		if !ok && value != nil {
+			return nil, fmt.Errorf("%s: %s is not a list", path, section)
+		}
+		for i, e := range list {
+			obj, err := asObject(e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s[%d]: %w", path, section, i, err)
+			}
+			*field(a) = append(*field(a), obj)
+		}
+	}
+
+	// scripts are relative to the directory of tenant.yaml
+	for _, db := range a.Databases {
+		a.loadScripts(db, filepath.Dir(path))
+	}
+	return a, nil
+}
+
+func loadDirectory(dir string) (*Assets, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Assets{}
+	for _, e := range entries {
+		name := e.Name()
+		switch {
+		case name == "tenant.json":
+			a.Tenant = management.Object{}
+			if err := readDocument(filepath.Join(dir, name), &a.Tenant); err != nil {
+				return nil, err
+			}
+		case name == "database-connections" && e.IsDir():
+			if err := a.loadDatabases(filepath.Join(dir, name)); err != nil {
+				return nil, err
+			}
+		case directories[name] != nil && e.IsDir():
+			objs, err := readDocuments(filepath.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+			field := directories[name](a)
+			*field = append(*field, objs...)
+		case e.IsDir() && !strings.HasPrefix(name, "."):
+			a.Warnings = append(a.Warnings, fmt.Sprintf("%s: %s has no A0* kind and was not converted", dir, name))
+		}
+	}
+	return a, nil
+}
+
+// loadDatabases reads the database.json of each directory of dir, with scripts relative to that directory.
+func (a *Assets) loadDatabases(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name(), "database.json")
+		db := management.Object{}
+		if err := readDocument(path, &db); err != nil {
+			return err
+		}
+		a.loadScripts(db, filepath.Dir(path))
+		a.Databases = append(a.Databases, db)
+	}
+	return nil
+}
+
+// loadScripts replaces the file names in the custom scripts of db with the contents of the files.
+func (a *Assets) loadScripts(db management.Object, dir string) {
+	options, _ := db["options"].(map[string]interface{})
+	scripts, _ := options["customScripts"].(map[string]interface{})
+	for _, name := range sortedKeys(scripts) {
+		file, ok := scripts[name].(string)
+		if !ok || !strings.HasSuffix(file, ".js") {
+			continue
+		}
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			a.Warnings = append(a.Warnings, fmt.Sprintf("database %s: script %s was not read: %v", entityName(db), name, err))
+			continue
+		}
+		scripts[name] = string(data)
+	}
+}
+
+// readDocuments reads the JSON files of dir in lexical order.
+func readDocuments(dir string) ([]management.Object, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	var objs []management.Object
+	for _, file := range files {
+		obj := management.Object{}
+		if err := readDocument(file, &obj); err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// readDocument reads a YAML or JSON file with keywords into v.
+func readDocument(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(quoteKeywords(data), v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func asObject(v interface{}) (management.Object, error) {
+	if v == nil {
+		return management.Object{}, nil
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		data, _ := json.Marshal(v)
+		return nil, fmt.Errorf("expected an object, got %s", data)
+	}
+	return obj, nil
+}
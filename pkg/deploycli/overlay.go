@@ -0,0 +1,94 @@
+package deploycli
+
+import (
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+// Overlay returns patches that set the Conf fields whose values come from keywords to the values of cfg, and the
+// domain of the A0Tenant to the domain of cfg. With the resources of the Conversion as the base, the patches form a
+// Kustomize overlay for the environment of cfg, see WriteOverlay. The warnings list the keywords cfg has no value for.
+func (c *Conversion) Overlay(cfg *Config) ([]client.Object, []string) {
+	r := newReplacer(cfg.Mappings)
+	patches := map[client.Object]*unstructured.Unstructured{}
+	patch := func(obj client.Object) *unstructured.Unstructured {
+		if p, ok := patches[obj]; ok {
+			return p
+		}
+		p := &unstructured.Unstructured{}
+		p.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		p.SetName(obj.GetName())
+		p.SetNamespace(obj.GetNamespace())
+		patches[obj] = p
+		return p
+	}
+
+	for _, f := range c.keywords {
+		p := patch(f.obj)
+		conf, _, _ := unstructured.NestedMap(p.Object, "spec", "conf")
+		if conf == nil {
+			conf = map[string]interface{}{}
+		}
+		conf[f.field] = r.replace(f.value)
+		_ = unstructured.SetNestedMap(p.Object, conf, "spec", "conf")
+	}
+	if cfg.Domain != "" && c.Tenant != nil {
+		_ = unstructured.SetNestedField(patch(c.Tenant).Object, cfg.Domain, "spec", "auth", "domain")
+	}
+
+	var objs []client.Object
+	for _, obj := range c.Objects() {
+		if p, ok := patches[obj]; ok {
+			objs = append(objs, p)
+		}
+	}
+	return objs, r.warnings()
+}
+
+// kustomization is the subset of a Kustomize kustomization.yaml written by WriteBase and WriteOverlay
+type kustomization struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Resources  []string         `json:"resources,omitempty"`
+	Patches    []kustomizePatch `json:"patches,omitempty"`
+}
+
+type kustomizePatch struct {
+	Path string `json:"path"`
+}
+
+// WriteBase writes objs to dir, one file each, with a kustomization.yaml that lists them.
+func WriteBase(dir string, objs []client.Object) error {
+	k := kustomization{APIVersion: "kustomize.config.k8s.io/v1beta1", Kind: "Kustomization"}
+	for _, obj := range objs {
+		k.Resources = append(k.Resources, manifest.FileName(obj))
+	}
+	return writeKustomization(dir, objs, k)
+}
+
+// WriteOverlay writes patches to dir, one file each, with a kustomization.yaml that applies them to base, the path
+// of the base relative to dir.
+func WriteOverlay(dir, base string, patches []client.Object) error {
+	k := kustomization{APIVersion: "kustomize.config.k8s.io/v1beta1", Kind: "Kustomization", Resources: []string{base}}
+	for _, p := range patches {
+		k.Patches = append(k.Patches, kustomizePatch{Path: manifest.FileName(p)})
+	}
+	return writeKustomization(dir, patches, k)
+}
+
+func writeKustomization(dir string, objs []client.Object, k kustomization) error {
+	if err := manifest.WriteFiles(dir, objs); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(k)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "kustomization.yaml"), data, 0o644)
+}
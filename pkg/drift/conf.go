@@ -0,0 +1,144 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+var (
+	clientRules = rules{
+		ignored:  map[string]bool{"client_id": true},
+		replaced: map[string]bool{"client_metadata": true},
+	}
+	connectionRules = rules{
+		ignored:  map[string]bool{"id": true},
+		replaced: map[string]bool{"metadata": true},
+		ordered:  map[string]bool{"options.precedence": true},
+	}
+	resourceServerRules = rules{
+		ignored: map[string]bool{"id": true},
+	}
+	clientGrantRules = rules{}
+	tenantRules      = rules{}
+)
+
+// Compare returns the differences between conf, one of *v1.ClientConf, *v1.ConnectionConf, *v1.ResourceServerConf,
+// *v1.ClientGrantConf or *v1.TenantConf, and the live entity as returned by the Management API.
+func Compare(conf interface{}, live management.Object) ([]Difference, error) {
+	switch c := conf.(type) {
+	case *v1.ClientConf:
+		return CompareClient(c, live)
+	case *v1.ConnectionConf:
+		return CompareConnection(c, live)
+	case *v1.ResourceServerConf:
+		return CompareResourceServer(c, live)
+	case *v1.ClientGrantConf:
+		return CompareClientGrant(c, live)
+	case *v1.TenantConf:
+		return CompareTenant(c, live)
+	default:
+		return nil, fmt.Errorf("drift: unsupported configuration type %T", conf)
+	}
+}
+
+// CompareClient compares a client configuration with a live client. Keys of client_metadata that the configuration
+// does not set are Removed, since the operator deletes them. enabled_connections is only compared when live has
+// it, as in Status.LastConf, and every reference has an id.
+func CompareClient(conf *v1.ClientConf, live management.Object) ([]Difference, error) {
+	desired, err := ToObject(conf)
+	if err != nil {
+		return nil, err
+	}
+	delete(desired, "enabled_connections")
+	if ids, ok := connectionIDs(conf); ok && live["enabled_connections"] != nil {
+		desired["enabled_connections"] = ids
+	}
+	return clientRules.compare(desired, live), nil
+}
+
+// CompareConnection compares a connection configuration with a live connection. Keys of metadata that the
+// configuration does not set are Removed, since Auth0 replaces the metadata as a whole.
+func CompareConnection(conf *v1.ConnectionConf, live management.Object) ([]Difference, error) {
+	desired, err := ToObject(conf)
+	if err != nil {
+		return nil, err
+	}
+	return connectionRules.compare(desired, live), nil
+}
+
+// CompareResourceServer compares a resource server configuration with a live resource server.
+func CompareResourceServer(conf *v1.ResourceServerConf, live management.Object) ([]Difference, error) {
+	desired, err := ToObject(conf)
+	if err != nil {
+		return nil, err
+	}
+	return resourceServerRules.compare(desired, live), nil
+}
+
+// CompareClientGrant compares a client grant configuration with a live client grant. The client and audience
+// references are compared as client_id and audience when they name an id and an identifier; references by name
+// need the Kubernetes API and are left out.
+func CompareClientGrant(conf *v1.ClientGrantConf, live management.Object) ([]Difference, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	desired := management.Object{}
+	if conf.ClientRef != nil && conf.ClientRef.Id != nil {
+		desired["client_id"] = *conf.ClientRef.Id
+	}
+	if conf.Audience != nil && conf.Audience.Identifier != nil {
+		desired["audience"] = *conf.Audience.Identifier
+	}
+	if conf.Scope != nil {
+		scope := make([]interface{}, len(conf.Scope))
+		for i, s := range conf.Scope {
+			scope[i] = s
+		}
+		desired["scope"] = scope
+	}
+	return clientGrantRules.compare(desired, live), nil
+}
+
+// CompareTenant compares a tenant configuration with the live tenant settings.
+func CompareTenant(conf *v1.TenantConf, live management.Object) ([]Difference, error) {
+	desired, err := ToObject(conf)
+	if err != nil {
+		return nil, err
+	}
+	return tenantRules.compare(desired, live), nil
+}
+
+// connectionIDs returns the ids of the enabled connections of conf, or false when it has references by name.
+func connectionIDs(conf *v1.ClientConf) ([]interface{}, bool) {
+	if conf == nil || conf.EnabledConnections == nil {
+		return nil, false
+	}
+	ids := make([]interface{}, 0, len(conf.EnabledConnections))
+	for _, ref := range conf.EnabledConnections {
+		if ref.Id == nil || *ref.Id == "" {
+			return nil, false
+		}
+		ids = append(ids, *ref.Id)
+	}
+	return ids, true
+}
+
+// ToObject converts a typed configuration to its Management API representation, an empty object for a nil
+// configuration.
+func ToObject(conf interface{}) (management.Object, error) {
+	data, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+	obj := management.Object{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj = management.Object{}
+	}
+	return obj, nil
+}
@@ -0,0 +1,290 @@
+// Package drift compares the configuration of A0* resources with the live state of their Auth0 entities and reports
+// the differences field by field, the same way the C# operator's drift detection does.
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+// ChangeType is the kind of a Difference
+type ChangeType string
+
+const (
+	// Added is a value the configuration sets that the live entity does not have
+	Added ChangeType = "Added"
+
+	// Modified is a value the configuration and the live entity both have, with different contents
+	Modified ChangeType = "Modified"
+
+	// Removed is a value the live entity has that applying the configuration removes
+	Removed ChangeType = "Removed"
+)
+
+// Redacted replaces the values of secrets in a Difference, the placeholder of the C# drift log
+const Redacted = "(redacted)"
+
+// Difference is a single field of the configuration that does not match the live entity
+type Difference struct {
+	// Path is the JSON path of the field in the Management API representation, e.g. "jwt_configuration.alg" or
+	// "callbacks[2]". List indexes refer to the configuration for Added and to the live entity for Removed values.
+	Path string `json:"path"`
+
+	// Type is how the live value has to change to match the configuration
+	Type ChangeType `json:"type"`
+
+	// Desired is the value of the configuration, nil for Removed values
+	Desired interface{} `json:"desired,omitempty"`
+
+	// Live is the value of the live entity, nil for Added values
+	Live interface{} `json:"live,omitempty"`
+}
+
+func (d Difference) String() string {
+	switch d.Type {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", d.Path, formatValue(d.Desired))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", d.Path, formatValue(d.Live))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", d.Path, formatValue(d.Live), formatValue(d.Desired))
+	}
+}
+
+func formatValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// rules describe how Auth0 stores the fields of an entity kind. Field paths omit list indexes, e.g. "scopes.value".
+type rules struct {
+	// ignored fields are never compared, e.g. IDs assigned by Auth0
+	ignored map[string]bool
+
+	// replaced fields are objects that Auth0 replaces as a whole, so keys missing from the configuration are Removed
+	replaced map[string]bool
+
+	// ordered fields are lists whose order Auth0 preserves and acts on, so they are compared element by element
+	ordered map[string]bool
+}
+
+// compare returns the differences between desired and live, sorted by path. Only the fields desired sets are
+// compared, since the Management API returns every field with its server default. Lists that are not ordered are
+// compared as sets, because Auth0 does not preserve the order of URL lists, grant types, scopes or locales.
+func (r rules) compare(desired, live management.Object) []Difference {
+	var diffs []Difference
+	r.compareObject("", "", desired, live, &diffs)
+	sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs
+}
+
+func (r rules) compareObject(path, field string, desired, live map[string]interface{}, diffs *[]Difference) {
+	for _, k := range sortedKeys(desired) {
+		d := desired[k]
+		if d == nil {
+			continue
+		}
+		p, f := join(path, k), joinField(field, k)
+		if r.ignored[f] {
+			continue
+		}
+		l, ok := live[k]
+		if !ok || l == nil {
+			// secrets are write-only in the Management API, so their absence is not drift
+			if _, isString := d.(string); isString && IsSensitiveKey(k) {
+				continue
+			}
+			*diffs = append(*diffs, Difference{Path: p, Type: Added, Desired: redact(k, d)})
+			continue
+		}
+		r.compareValue(p, f, k, d, l, diffs)
+	}
+
+	if r.replaced[field] {
+		for _, k := range sortedKeys(live) {
+			if _, ok := desired[k]; !ok && live[k] != nil {
+				*diffs = append(*diffs, Difference{Path: join(path, k), Type: Removed, Live: redact(k, live[k])})
+			}
+		}
+	}
+}
+
+func (r rules) compareValue(path, field, key string, desired, live interface{}, diffs *[]Difference) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		if l, ok := live.(map[string]interface{}); ok {
+			r.compareObject(path, field, d, l, diffs)
+			return
+		}
+	case []interface{}:
+		if l, ok := live.([]interface{}); ok {
+			if r.ordered[field] {
+				r.compareOrderedList(path, field, key, d, l, diffs)
+			} else {
+				r.compareList(path, key, d, l, diffs)
+			}
+			return
+		}
+	default:
+		if reflect.DeepEqual(desired, live) {
+			return
+		}
+	}
+	*diffs = append(*diffs, Difference{Path: path, Type: Modified, Desired: redact(key, desired), Live: redact(key, live)})
+}
+
+// compareList matches the elements of desired and live regardless of their order. An element of desired matches a
+// live element that contains it, so objects in lists are also compared by the fields the configuration sets.
+func (r rules) compareList(path, key string, desired, live []interface{}, diffs *[]Difference) {
+	matched := make([]bool, len(live))
+	for i, d := range desired {
+		found := false
+		for j, l := range live {
+			if !matched[j] && contains(l, d) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			*diffs = append(*diffs, Difference{Path: fmt.Sprintf("%s[%d]", path, i), Type: Added, Desired: redact(key, d)})
+		}
+	}
+	for j, l := range live {
+		if !matched[j] {
+			*diffs = append(*diffs, Difference{Path: fmt.Sprintf("%s[%d]", path, j), Type: Removed, Live: redact(key, l)})
+		}
+	}
+}
+
+// compareOrderedList matches the elements of desired and live by their index.
+func (r rules) compareOrderedList(path, field, key string, desired, live []interface{}, diffs *[]Difference) {
+	for i := 0; i < len(desired) || i < len(live); i++ {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(live):
+			*diffs = append(*diffs, Difference{Path: p, Type: Added, Desired: redact(key, desired[i])})
+		case i >= len(desired):
+			*diffs = append(*diffs, Difference{Path: p, Type: Removed, Live: redact(key, live[i])})
+		default:
+			r.compareValue(p, field, key, desired[i], live[i], diffs)
+		}
+	}
+}
+
+// contains reports whether live has every value that desired sets.
+func contains(live, desired interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if v != nil && !contains(l[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return false
+		}
+		var diffs []Difference
+		rules{}.compareList("", "", d, l, &diffs)
+		return len(diffs) == 0
+	default:
+		return reflect.DeepEqual(live, desired)
+	}
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// join appends key to a JSON path, quoting keys that are not identifiers, e.g. client_metadata["team.name"].
+func join(path, key string) string {
+	if !identifierPattern.MatchString(key) {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// joinField appends key to a field path of rules, which never contains list indexes.
+func joinField(field, key string) string {
+	if field == "" {
+		return key
+	}
+	return field + "." + key
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsSensitiveKey reports whether the field named key holds a secret, whose value is never part of a Difference. It
+// matches the same keys as LogValueFormatter.IsSensitiveKey of the C# operator; false positives are accepted.
+func IsSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, marker := range []string{"secret", "password", "credential", "signing_cert", "signing_key", "kerberos", "private_key", "api_key", "client_assertion", "certificate"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	for _, token := range []string{"access_token", "refresh_token", "id_token"} {
+		if hasSuffixSegment(lower, token) {
+			return true
+		}
+	}
+	for _, segment := range strings.FieldsFunc(lower, func(r rune) bool { return r == '_' || r == '.' }) {
+		if segment == "pfx" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSuffixSegment(key, segment string) bool {
+	if key == segment {
+		return true
+	}
+	return strings.HasSuffix(key, "_"+segment) || strings.HasSuffix(key, "."+segment)
+}
+
+// redact returns v, with the values of sensitive keys replaced by Redacted.
+func redact(key string, v interface{}) interface{} {
+	if IsSensitiveKey(key) {
+		return Redacted
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			out[k] = redact(k, item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, item := range t {
+			out[i] = redact("", item)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,247 @@
+package drift_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+func strPtr(s string) *string { return &s }
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestCompareClient(t *testing.T) {
+	conf := &v1.ClientConf{
+		Name:              strPtr("my-app"),
+		ApplicationType:   strPtr("spa"),
+		Callbacks:         []string{"https://a.example.com/cb", "https://b.example.com/cb"},
+		AllowedLogoutUrls: []string{"https://a.example.com"},
+		JwtConfiguration:  &v1.JwtConfiguration{SigningAlgorithm: strPtr("RS256")},
+		ClientMetadata:    &runtime.RawExtension{Raw: []byte(`{"team":"identity"}`)},
+		OidcConformant:    boolPtr(true),
+	}
+	live := management.Object{
+		"client_id":           "abc",
+		"client_secret":       "s3cr3t",
+		"name":                "my-app",
+		"app_type":            "spa",
+		"callbacks":           []interface{}{"https://b.example.com/cb", "https://a.example.com/cb"},
+		"allowed_logout_urls": []interface{}{"https://a.example.com", "https://old.example.com"},
+		"jwt_configuration":   map[string]interface{}{"alg": "HS256", "lifetime_in_seconds": float64(36000)},
+		"client_metadata":     map[string]interface{}{"team": "identity", "owner": "someone"},
+		"is_first_party":      true,
+	}
+
+	diffs, err := drift.Compare(conf, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []drift.Difference{
+		{Path: "allowed_logout_urls[1]", Type: drift.Removed, Live: "https://old.example.com"},
+		{Path: "client_metadata.owner", Type: drift.Removed, Live: "someone"},
+		{Path: "jwt_configuration.alg", Type: drift.Modified, Desired: "RS256", Live: "HS256"},
+		{Path: "oidc_conformant", Type: drift.Added, Desired: true},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+}
+
+func TestCompareClientEnabledConnections(t *testing.T) {
+	conf := &v1.ClientConf{EnabledConnections: []v1.V1ConnectionReference{{Id: strPtr("con_1")}, {Id: strPtr("con_2")}}}
+
+	diffs, err := drift.CompareClient(conf, management.Object{"enabled_connections": []interface{}{"con_2", "con_3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []drift.Difference{
+		{Path: "enabled_connections[0]", Type: drift.Added, Desired: "con_1"},
+		{Path: "enabled_connections[1]", Type: drift.Removed, Live: "con_3"},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+
+	// references by name cannot be resolved without the Kubernetes API
+	conf.EnabledConnections[1] = v1.V1ConnectionReference{Name: strPtr("db")}
+	if diffs, _ := drift.CompareClient(conf, management.Object{"enabled_connections": []interface{}{"con_3"}}); len(diffs) != 0 {
+		t.Errorf("diffs = %v, want none", diffs)
+	}
+}
+
+func TestCompareResourceServerSecrets(t *testing.T) {
+	conf := &v1.ResourceServerConf{
+		Identifier:       strPtr("https://api.example.com"),
+		SigningAlgorithm: strPtr("HS256"),
+		SigningSecret:    strPtr("new-secret"),
+		Scopes: []v1.ResourceServerScope{
+			{Value: strPtr("read:things")},
+			{Value: strPtr("write:things"), Description: strPtr("Write things")},
+		},
+	}
+
+	// the secret is not returned, so it cannot drift
+	live := management.Object{
+		"id":          "rs_1",
+		"identifier":  "https://api.example.com",
+		"signing_alg": "HS256",
+		"scopes": []interface{}{
+			map[string]interface{}{"value": "write:things", "description": "Write things"},
+			map[string]interface{}{"value": "read:things", "description": "Read things"},
+		},
+	}
+	diffs, err := drift.Compare(conf, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("diffs = %v, want none", diffs)
+	}
+
+	// a returned secret is compared, but its values are redacted
+	live["signing_secret"] = "old-secret"
+	diffs, _ = drift.Compare(conf, live)
+	want := []drift.Difference{{Path: "signing_secret", Type: drift.Modified, Desired: drift.Redacted, Live: drift.Redacted}}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+}
+
+func TestCompareConnection(t *testing.T) {
+	conf := &v1.ConnectionConf{
+		Name:     strPtr("google-oauth2"),
+		Strategy: strPtr("google-oauth2"),
+		Options:  &runtime.RawExtension{Raw: []byte(`{"client_id":"gid","client_secret":"gsecret","scope":["email","profile"]}`)},
+		Metadata: &runtime.RawExtension{Raw: []byte(`{"my.key":"a"}`)},
+	}
+	live := management.Object{
+		"id":       "con_1",
+		"name":     "google-oauth2",
+		"strategy": "google-oauth2",
+		"options":  map[string]interface{}{"client_id": "other", "scope": []interface{}{"profile", "email"}, "email": true},
+		"metadata": map[string]interface{}{"my.key": "b"},
+	}
+
+	diffs, err := drift.Compare(conf, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []drift.Difference{
+		{Path: `metadata["my.key"]`, Type: drift.Modified, Desired: "a", Live: "b"},
+		{Path: "options.client_id", Type: drift.Modified, Desired: "gid", Live: "other"},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+}
+
+func TestCompareConnectionPrecedence(t *testing.T) {
+	conf := &v1.ConnectionConf{
+		Name:     strPtr("Username-Password-Authentication"),
+		Strategy: strPtr("auth0"),
+		Options:  &runtime.RawExtension{Raw: []byte(`{"precedence":["username","email","phone_number"],"non_persistent_attrs":["ethnicity","gender"]}`)},
+	}
+	live := management.Object{
+		"id":       "con_1",
+		"name":     "Username-Password-Authentication",
+		"strategy": "auth0",
+		"options": map[string]interface{}{
+			"precedence":           []interface{}{"email", "username"},
+			"non_persistent_attrs": []interface{}{"gender", "ethnicity"},
+		},
+	}
+
+	diffs, err := drift.Compare(conf, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the order of precedence decides which identifier is tried first, unlike the order of other lists
+	want := []drift.Difference{
+		{Path: "options.precedence[0]", Type: drift.Modified, Desired: "username", Live: "email"},
+		{Path: "options.precedence[1]", Type: drift.Modified, Desired: "email", Live: "username"},
+		{Path: "options.precedence[2]", Type: drift.Added, Desired: "phone_number"},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+
+	live["options"].(map[string]interface{})["precedence"] = []interface{}{"username", "email", "phone_number", "email"}
+	diffs, _ = drift.Compare(conf, live)
+	want = []drift.Difference{{Path: "options.precedence[3]", Type: drift.Removed, Live: "email"}}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+}
+
+func TestCompareClientGrant(t *testing.T) {
+	conf := &v1.ClientGrantConf{
+		ClientRef: &v1.V1ClientReference{Name: strPtr("my-app")},
+		Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("https://api.example.com")},
+		Scope:     []string{"read:things", "write:things"},
+	}
+	live := management.Object{"client_id": "abc", "audience": "https://api.example.com", "scope": []interface{}{"write:things"}}
+
+	diffs, err := drift.Compare(conf, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []drift.Difference{{Path: "scope[0]", Type: drift.Added, Desired: "read:things"}}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffs = %v, want %v", diffs, want)
+	}
+}
+
+func TestCompareTenant(t *testing.T) {
+	conf := &v1.TenantConf{FriendlyName: strPtr("Example"), EnabledLocales: []string{"en", "de"}}
+	live := management.Object{"friendly_name": "Example", "enabled_locales": []interface{}{"de", "en"}, "sandbox_version": "18"}
+
+	diffs, err := drift.Compare(conf, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("diffs = %v, want none", diffs)
+	}
+
+	if _, err := drift.Compare(&v1.A0Tenant{}, live); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"client_secret":              true,
+		"bind_password":              true,
+		"signing_cert":               true,
+		"pfx":                        true,
+		"oauth.access_token":         true,
+		"token_endpoint_auth_method": false,
+		"prefix_url":                 false,
+		"access_token_lifetime":      false,
+		"name":                       false,
+	} {
+		if got := drift.IsSensitiveKey(key); got != want {
+			t.Errorf("IsSensitiveKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	for _, tc := range []struct {
+		diff drift.Difference
+		want string
+	}{
+		{drift.Difference{Path: "name", Type: drift.Modified, Desired: "a", Live: "b"}, `~ name: "b" -> "a"`},
+		{drift.Difference{Path: "callbacks[0]", Type: drift.Added, Desired: "https://x"}, `+ callbacks[0]: "https://x"`},
+		{drift.Difference{Path: "scope[1]", Type: drift.Removed, Live: "read"}, `- scope[1]: "read"`},
+	} {
+		if got := tc.diff.String(); got != tc.want {
+			t.Errorf("String() = %s, want %s", got, tc.want)
+		}
+	}
+}
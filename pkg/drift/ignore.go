@@ -0,0 +1,271 @@
+package drift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+// segment is a step of a field path: an object key, or a list index when list is set. The key or index "*" matches
+// any key or index.
+type segment struct {
+	key   string
+	index string
+	list  bool
+}
+
+// ValidateFieldPath checks the syntax of a field path as used by the ignoreFields of A0* resources and by
+// Difference.Path: object keys separated by dots, or quoted in brackets when they are not identifiers, and list
+// indexes in brackets, e.g. "jwt_configuration.alg", "client_metadata[\"team.name\"]" or "callbacks[*]". A leading
+// "$." is allowed, and "*" matches any key or index.
+func ValidateFieldPath(path string) error {
+	_, err := parseFieldPath(path)
+	return err
+}
+
+func parseFieldPath(path string) ([]segment, error) {
+	rest := path
+	if strings.HasPrefix(rest, "$") {
+		rest = strings.TrimPrefix(rest[1:], ".")
+	}
+	if rest == "" {
+		return nil, fmt.Errorf("empty field path %q", path)
+	}
+
+	var segments []segment
+	for first := true; rest != ""; first = false {
+		if rest[0] == '[' {
+			seg, n, err := parseBracket(rest)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field path %q: %w", path, err)
+			}
+			segments, rest = append(segments, seg), rest[n:]
+			continue
+		}
+
+		if !first {
+			if rest[0] != '.' {
+				return nil, fmt.Errorf("invalid field path %q: expected . or [ before %q", path, rest)
+			}
+			rest = rest[1:]
+		}
+		n := strings.IndexAny(rest, ".[")
+		if n < 0 {
+			n = len(rest)
+		}
+		if n == 0 {
+			return nil, fmt.Errorf("invalid field path %q: empty key", path)
+		}
+		segments, rest = append(segments, segment{key: rest[:n]}), rest[n:]
+	}
+	if segments[0].list {
+		return nil, fmt.Errorf("invalid field path %q: expected a key before the list index", path)
+	}
+	return segments, nil
+}
+
+// parseBracket parses the bracket segment at the start of s, and returns it with its length.
+func parseBracket(s string) (segment, int, error) {
+	if strings.HasPrefix(s, `["`) {
+		quoted, err := strconv.QuotedPrefix(s[1:])
+		if err != nil {
+			return segment{}, 0, fmt.Errorf("unterminated quoted key in %q", s)
+		}
+		end := 1 + len(quoted)
+		if end >= len(s) || s[end] != ']' {
+			return segment{}, 0, fmt.Errorf("expected ] after %s", quoted)
+		}
+		key, _ := strconv.Unquote(quoted)
+		return segment{key: key}, end + 1, nil
+	}
+
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return segment{}, 0, fmt.Errorf("unterminated [ in %q", s)
+	}
+	index := s[1:end]
+	if index != "*" {
+		if _, err := strconv.ParseUint(index, 10, 32); err != nil {
+			return segment{}, 0, fmt.Errorf("expected a list index or * in %q", s[:end+1])
+		}
+	}
+	return segment{index: index, list: true}, end + 1, nil
+}
+
+// fieldPaths parses paths, leaving out invalid ones, which admission validation rejects.
+func fieldPaths(paths []string) [][]segment {
+	out := make([][]segment, 0, len(paths))
+	for _, p := range paths {
+		if segments, err := parseFieldPath(p); err == nil {
+			out = append(out, segments)
+		}
+	}
+	return out
+}
+
+// under reports whether the field at path is the field at prefix, or one of its elements or nested fields.
+func under(path, prefix []segment) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i, p := range prefix {
+		s := path[i]
+		switch {
+		case p.list != s.list:
+			return false
+		case p.list && p.index != "*" && p.index != s.index:
+			return false
+		case !p.list && p.key != "*" && p.key != s.key:
+			return false
+		}
+	}
+	return true
+}
+
+// isIgnored reports whether path is under one of ignored.
+func isIgnored(path string, ignored [][]segment) bool {
+	segments, err := parseFieldPath(path)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range ignored {
+		if under(segments, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ignore returns the differences of diffs that are not under one of ignoreFields, see ValidateFieldPath. Ignoring a
+// field ignores its nested fields and list elements too, e.g. "callbacks" ignores "callbacks[2]".
+func Ignore(diffs []Difference, ignoreFields []string) []Difference {
+	if len(ignoreFields) == 0 {
+		return diffs
+	}
+	ignored := fieldPaths(ignoreFields)
+	out := make([]Difference, 0, len(diffs))
+	for _, d := range diffs {
+		if !isIgnored(d.Path, ignored) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// IgnoreChanges is Ignore for the changes of a three-way comparison.
+func IgnoreChanges(changes []Change, ignoreFields []string) []Change {
+	if len(ignoreFields) == 0 {
+		return changes
+	}
+	ignored := fieldPaths(ignoreFields)
+	out := make([]Change, 0, len(changes))
+	for _, c := range changes {
+		if !isIgnored(c.Path, ignored) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CompareIgnoring is Compare without the differences under one of ignoreFields.
+func CompareIgnoring(conf interface{}, live management.Object, ignoreFields []string) ([]Difference, error) {
+	diffs, err := Compare(conf, live)
+	if err != nil {
+		return nil, err
+	}
+	return Ignore(diffs, ignoreFields), nil
+}
+
+// ThreeWayIgnoring is ThreeWayRaw without the changes under one of ignoreFields.
+func ThreeWayIgnoring(conf interface{}, lastApplied *runtime.RawExtension, live management.Object, ignoreFields []string) ([]Change, error) {
+	changes, err := ThreeWayRaw(conf, lastApplied, live)
+	if err != nil {
+		return nil, err
+	}
+	return IgnoreChanges(changes, ignoreFields), nil
+}
+
+// Omit removes the fields under paths from obj, an entity in its Management API representation, so that an update
+// with obj leaves them as they are in Auth0. A path into a list removes the whole list, since Auth0 replaces lists as
+// a whole.
+func Omit(obj management.Object, paths []string) {
+	for _, segments := range fieldPaths(paths) {
+		walk(obj, segments, func(parent map[string]interface{}, key string) {
+			delete(parent, key)
+		})
+	}
+}
+
+// CopyFields sets the fields under paths of dst to their values in src, and removes those that src does not have.
+// Like Omit, a path into a list copies the whole list.
+func CopyFields(dst, src management.Object, paths []string) {
+	for _, segments := range fieldPaths(paths) {
+		copyFields(dst, src, segments)
+	}
+}
+
+func copyFields(dst, src map[string]interface{}, segments []segment) {
+	seg := segments[0]
+	if seg.list {
+		return
+	}
+	for _, key := range matchingKeys(seg, dst, src) {
+		if len(segments) == 1 || segments[1].list {
+			if v, ok := src[key]; ok {
+				dst[key] = v
+			} else {
+				delete(dst, key)
+			}
+			continue
+		}
+
+		child, _ := src[key].(map[string]interface{})
+		target, ok := dst[key].(map[string]interface{})
+		if !ok {
+			if child == nil {
+				continue
+			}
+			target = map[string]interface{}{}
+			dst[key] = target
+		}
+		copyFields(target, child, segments[1:])
+	}
+}
+
+// walk calls fn with the object and key of each field that segments lead to, stopping at the first list.
+func walk(obj map[string]interface{}, segments []segment, fn func(parent map[string]interface{}, key string)) {
+	seg := segments[0]
+	if seg.list {
+		return
+	}
+	for _, key := range matchingKeys(seg, obj) {
+		if len(segments) == 1 || segments[1].list {
+			fn(obj, key)
+			continue
+		}
+		if child, ok := obj[key].(map[string]interface{}); ok {
+			walk(child, segments[1:], fn)
+		}
+	}
+}
+
+// matchingKeys returns the keys of objs that seg matches.
+func matchingKeys(seg segment, objs ...map[string]interface{}) []string {
+	if seg.key != "*" {
+		return []string{seg.key}
+	}
+	seen := map[string]bool{}
+	var keys []string
+	for _, obj := range objs {
+		for _, key := range sortedKeys(obj) {
+			if !seen[key] {
+				seen[key], keys = true, append(keys, key)
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,81 @@
+package drift_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+func TestValidateFieldPath(t *testing.T) {
+	for _, path := range []string{"logo_uri", "$.logo_uri", "jwt_configuration.alg", `client_metadata["team.name"]`, "callbacks[*]", "callbacks[2]", "client_metadata.*"} {
+		if err := drift.ValidateFieldPath(path); err != nil {
+			t.Errorf("ValidateFieldPath(%q) = %v", path, err)
+		}
+	}
+	for _, path := range []string{"", "$", ".logo_uri", "jwt_configuration..alg", "callbacks[x]", "callbacks[", `client_metadata["team`, "[0]", "callbacks[0]x"} {
+		if err := drift.ValidateFieldPath(path); err == nil {
+			t.Errorf("ValidateFieldPath(%q) succeeded, want an error", path)
+		}
+	}
+}
+
+func TestCompareIgnoring(t *testing.T) {
+	conf := &v1.ClientConf{
+		Name:           strPtr("Web"),
+		LogoUri:        strPtr("https://example.com/logo.png"),
+		Callbacks:      []string{"https://example.com/cb"},
+		ClientMetadata: &runtime.RawExtension{Raw: []byte(`{"team": "identity", "team.name": "Identity"}`)},
+	}
+	live := management.Object{
+		"name":            "Web (edited)",
+		"logo_uri":        "https://example.com/dashboard.png",
+		"callbacks":       []interface{}{"https://example.com/cb", "https://preview.example.com/cb"},
+		"client_metadata": map[string]interface{}{"team": "platform", "team.name": "Platform"},
+	}
+
+	diffs, err := drift.CompareIgnoring(conf, live, []string{"$.logo_uri", "callbacks[*]", `client_metadata["team.name"]`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, d := range diffs {
+		paths = append(paths, d.Path)
+	}
+	if want := []string{"client_metadata.team", "name"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("got %v, want %v", paths, want)
+	}
+
+	if diffs, _ := drift.CompareIgnoring(conf, live, []string{"*"}); len(diffs) != 0 {
+		t.Errorf("expected * to ignore every field, got %v", diffs)
+	}
+}
+
+func TestOmitAndCopyFields(t *testing.T) {
+	desired := management.Object{
+		"name":              "Web",
+		"logo_uri":          "https://example.com/logo.png",
+		"callbacks":         []interface{}{"https://example.com/cb"},
+		"jwt_configuration": map[string]interface{}{"alg": "RS256", "lifetime_in_seconds": float64(36000)},
+	}
+	drift.Omit(desired, []string{"logo_uri", "callbacks[1]", "jwt_configuration.alg", "missing.field"})
+	want := management.Object{
+		"name":              "Web",
+		"jwt_configuration": map[string]interface{}{"lifetime_in_seconds": float64(36000)},
+	}
+	if !reflect.DeepEqual(desired, want) {
+		t.Errorf("Omit: got %v, want %v", desired, want)
+	}
+
+	last := management.Object{"name": "Web", "logo_uri": "https://example.com/logo.png", "jwt_configuration": map[string]interface{}{"alg": "RS256"}}
+	live := management.Object{"name": "Web", "logo_uri": "https://example.com/dashboard.png", "description": "edited", "jwt_configuration": map[string]interface{}{"alg": "HS256"}}
+	drift.CopyFields(live, last, []string{"logo_uri", "description", "jwt_configuration.alg"})
+	want = management.Object{"name": "Web", "logo_uri": "https://example.com/logo.png", "jwt_configuration": map[string]interface{}{"alg": "RS256"}}
+	if !reflect.DeepEqual(live, want) {
+		t.Errorf("CopyFields: got %v, want %v", live, want)
+	}
+}
@@ -0,0 +1,110 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+)
+
+// Origin tells which side of a three-way comparison a Change comes from
+type Origin string
+
+const (
+	// UserEdit is a change of the configuration since it was last applied, while the live entity is unchanged
+	UserEdit Origin = "UserEdit"
+
+	// OutOfBand is a change of the live entity since the configuration was last applied, e.g. in the Auth0 dashboard
+	OutOfBand Origin = "OutOfBand"
+
+	// Conflict is a field that changed both in the configuration and in the live entity, to different values
+	Conflict Origin = "Conflict"
+)
+
+// Change is a Difference between the configuration and the live entity, classified by where it comes from
+type Change struct {
+	Difference `json:",inline"`
+
+	// Origin is where the difference comes from
+	Origin Origin `json:"origin"`
+
+	// LastApplied is the value of the field when the configuration was last applied, nil when it was unset
+	LastApplied interface{} `json:"lastApplied,omitempty"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s (%s)", c.Difference, c.Origin)
+}
+
+// ThreeWay compares conf, see Compare, with the live entity and classifies each difference with lastApplied, the
+// entity as it was after the configuration was last applied, i.e. Status.LastConf. Like kubectl apply:
+//
+//   - a field where the configuration differs from lastApplied but live does not is a UserEdit
+//   - a field where the configuration still matches lastApplied but live does not is OutOfBand
+//   - a field where all three differ is a Conflict
+//
+// Fields where the configuration and live agree are not reported, even if both changed. Without lastApplied, every
+// difference is a UserEdit, since the configuration has never been applied.
+func ThreeWay(conf interface{}, lastApplied, live management.Object) ([]Change, error) {
+	diffs, err := Compare(conf, live)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]Change, 0, len(diffs))
+	if lastApplied == nil {
+		for _, d := range diffs {
+			changes = append(changes, Change{Difference: d, Origin: UserEdit})
+		}
+		return changes, nil
+	}
+
+	applied, err := Compare(conf, lastApplied)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]Difference, len(applied))
+	for _, d := range applied {
+		byKey[changeKey(d)] = d
+	}
+
+	for _, d := range diffs {
+		a, edited := byKey[changeKey(d)]
+		switch {
+		case !edited:
+			// the configuration matches lastApplied, so live moved away from it
+			changes = append(changes, Change{Difference: d, Origin: OutOfBand, LastApplied: d.Desired})
+		case reflect.DeepEqual(a.Live, d.Live):
+			changes = append(changes, Change{Difference: d, Origin: UserEdit, LastApplied: a.Live})
+		default:
+			changes = append(changes, Change{Difference: d, Origin: Conflict, LastApplied: a.Live})
+		}
+	}
+	return changes, nil
+}
+
+// ThreeWayRaw is ThreeWay with lastApplied as stored in the status of an entity.
+func ThreeWayRaw(conf interface{}, lastApplied *runtime.RawExtension, live management.Object) ([]Change, error) {
+	var last management.Object
+	if lastApplied != nil && len(lastApplied.Raw) > 0 {
+		if err := json.Unmarshal(lastApplied.Raw, &last); err != nil {
+			return nil, fmt.Errorf("invalid lastConf: %w", err)
+		}
+	}
+	return ThreeWay(conf, last, live)
+}
+
+var listIndexSuffix = regexp.MustCompile(`\[\d+\]$`)
+
+// changeKey identifies a difference across comparisons with different entities. Removed list entries are indexed by
+// their position in the entity, so they are identified by their list and value instead.
+func changeKey(d Difference) string {
+	if d.Type != Removed || !listIndexSuffix.MatchString(d.Path) {
+		return d.Path
+	}
+	return listIndexSuffix.ReplaceAllString(d.Path, "") + "=" + formatValue(d.Live)
+}
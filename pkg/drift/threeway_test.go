@@ -0,0 +1,75 @@
+package drift_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+func TestThreeWay(t *testing.T) {
+	conf := &v1.ClientConf{
+		Name:        strPtr("renamed"),
+		Description: strPtr("same"),
+		LogoUri:     strPtr("https://mine.example.com/logo.png"),
+		Callbacks:   []string{"https://a.example.com/cb"},
+	}
+	lastApplied := management.Object{
+		"name":        "my-app",
+		"description": "same",
+		"logo_uri":    "https://old.example.com/logo.png",
+		"callbacks":   []interface{}{"https://a.example.com/cb"},
+	}
+	live := management.Object{
+		"name":        "my-app",
+		"description": "edited in the dashboard",
+		"logo_uri":    "https://theirs.example.com/logo.png",
+		"callbacks":   []interface{}{"https://a.example.com/cb", "https://extra.example.com/cb"},
+	}
+
+	changes, err := drift.ThreeWay(conf, lastApplied, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []drift.Change{
+		{Difference: drift.Difference{Path: "callbacks[1]", Type: drift.Removed, Live: "https://extra.example.com/cb"}, Origin: drift.OutOfBand},
+		{Difference: drift.Difference{Path: "description", Type: drift.Modified, Desired: "same", Live: "edited in the dashboard"}, Origin: drift.OutOfBand, LastApplied: "same"},
+		{Difference: drift.Difference{Path: "logo_uri", Type: drift.Modified, Desired: "https://mine.example.com/logo.png", Live: "https://theirs.example.com/logo.png"}, Origin: drift.Conflict, LastApplied: "https://old.example.com/logo.png"},
+		{Difference: drift.Difference{Path: "name", Type: drift.Modified, Desired: "renamed", Live: "my-app"}, Origin: drift.UserEdit, LastApplied: "my-app"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("changes = %v, want %v", changes, want)
+	}
+}
+
+func TestThreeWayRemovedListEntry(t *testing.T) {
+	// the user dropped a callback that is still live at another index than in lastApplied
+	conf := &v1.ClientConf{Callbacks: []string{"https://a.example.com/cb"}}
+	lastApplied := management.Object{"callbacks": []interface{}{"https://a.example.com/cb", "https://b.example.com/cb"}}
+	live := management.Object{"callbacks": []interface{}{"https://b.example.com/cb", "https://a.example.com/cb"}}
+
+	changes, err := drift.ThreeWayRaw(conf, &runtime.RawExtension{Raw: []byte(`{"callbacks":["https://a.example.com/cb","https://b.example.com/cb"]}`)}, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Origin != drift.UserEdit || changes[0].Path != "callbacks[0]" {
+		t.Errorf("changes = %v, want the removal of callbacks[0] as a user edit", changes)
+	}
+	if direct, _ := drift.ThreeWay(conf, lastApplied, live); !reflect.DeepEqual(direct, changes) {
+		t.Errorf("ThreeWay = %v, ThreeWayRaw = %v", direct, changes)
+	}
+}
+
+func TestThreeWayWithoutLastApplied(t *testing.T) {
+	changes, err := drift.ThreeWayRaw(&v1.ResourceServerConf{Name: strPtr("api")}, nil, management.Object{"name": "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Origin != drift.UserEdit {
+		t.Errorf("changes = %v, want a single user edit", changes)
+	}
+}
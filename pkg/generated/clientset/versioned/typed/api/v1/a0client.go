@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	scheme "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// A0ClientsGetter has a method to return a A0ClientInterface.
+// A group's client should implement this interface.
+type A0ClientsGetter interface {
+	A0Clients(namespace string) A0ClientInterface
+}
+
+// A0ClientInterface has methods to work with A0Client resources.
+type A0ClientInterface interface {
+	Create(ctx context.Context, a0Client *apiv1.A0Client, opts metav1.CreateOptions) (*apiv1.A0Client, error)
+	Update(ctx context.Context, a0Client *apiv1.A0Client, opts metav1.UpdateOptions) (*apiv1.A0Client, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, a0Client *apiv1.A0Client, opts metav1.UpdateOptions) (*apiv1.A0Client, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*apiv1.A0Client, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*apiv1.A0ClientList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *apiv1.A0Client, err error)
+	A0ClientExpansion
+}
+
+// a0Clients implements A0ClientInterface
+type a0Clients struct {
+	*gentype.ClientWithList[*apiv1.A0Client, *apiv1.A0ClientList]
+}
+
+// newA0Clients returns a A0Clients
+func newA0Clients(c *KubernetesV1Client, namespace string) *a0Clients {
+	return &a0Clients{
+		gentype.NewClientWithList[*apiv1.A0Client, *apiv1.A0ClientList](
+			"a0clients",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1.A0Client { return &apiv1.A0Client{} },
+			func() *apiv1.A0ClientList { return &apiv1.A0ClientList{} },
+		),
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	scheme "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// A0ConnectionsGetter has a method to return a A0ConnectionInterface.
+// A group's client should implement this interface.
+type A0ConnectionsGetter interface {
+	A0Connections(namespace string) A0ConnectionInterface
+}
+
+// A0ConnectionInterface has methods to work with A0Connection resources.
+type A0ConnectionInterface interface {
+	Create(ctx context.Context, a0Connection *apiv1.A0Connection, opts metav1.CreateOptions) (*apiv1.A0Connection, error)
+	Update(ctx context.Context, a0Connection *apiv1.A0Connection, opts metav1.UpdateOptions) (*apiv1.A0Connection, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, a0Connection *apiv1.A0Connection, opts metav1.UpdateOptions) (*apiv1.A0Connection, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*apiv1.A0Connection, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*apiv1.A0ConnectionList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *apiv1.A0Connection, err error)
+	A0ConnectionExpansion
+}
+
+// a0Connections implements A0ConnectionInterface
+type a0Connections struct {
+	*gentype.ClientWithList[*apiv1.A0Connection, *apiv1.A0ConnectionList]
+}
+
+// newA0Connections returns a A0Connections
+func newA0Connections(c *KubernetesV1Client, namespace string) *a0Connections {
+	return &a0Connections{
+		gentype.NewClientWithList[*apiv1.A0Connection, *apiv1.A0ConnectionList](
+			"a0connections",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1.A0Connection { return &apiv1.A0Connection{} },
+			func() *apiv1.A0ConnectionList { return &apiv1.A0ConnectionList{} },
+		),
+	}
+}
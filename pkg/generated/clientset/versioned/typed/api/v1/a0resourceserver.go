@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	scheme "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// A0ResourceServersGetter has a method to return a A0ResourceServerInterface.
+// A group's client should implement this interface.
+type A0ResourceServersGetter interface {
+	A0ResourceServers(namespace string) A0ResourceServerInterface
+}
+
+// A0ResourceServerInterface has methods to work with A0ResourceServer resources.
+type A0ResourceServerInterface interface {
+	Create(ctx context.Context, a0ResourceServer *apiv1.A0ResourceServer, opts metav1.CreateOptions) (*apiv1.A0ResourceServer, error)
+	Update(ctx context.Context, a0ResourceServer *apiv1.A0ResourceServer, opts metav1.UpdateOptions) (*apiv1.A0ResourceServer, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, a0ResourceServer *apiv1.A0ResourceServer, opts metav1.UpdateOptions) (*apiv1.A0ResourceServer, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*apiv1.A0ResourceServer, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*apiv1.A0ResourceServerList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *apiv1.A0ResourceServer, err error)
+	A0ResourceServerExpansion
+}
+
+// a0ResourceServers implements A0ResourceServerInterface
+type a0ResourceServers struct {
+	*gentype.ClientWithList[*apiv1.A0ResourceServer, *apiv1.A0ResourceServerList]
+}
+
+// newA0ResourceServers returns a A0ResourceServers
+func newA0ResourceServers(c *KubernetesV1Client, namespace string) *a0ResourceServers {
+	return &a0ResourceServers{
+		gentype.NewClientWithList[*apiv1.A0ResourceServer, *apiv1.A0ResourceServerList](
+			"a0resourceservers",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1.A0ResourceServer { return &apiv1.A0ResourceServer{} },
+			func() *apiv1.A0ResourceServerList { return &apiv1.A0ResourceServerList{} },
+		),
+	}
+}
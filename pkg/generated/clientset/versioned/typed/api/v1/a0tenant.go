@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	scheme "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// A0TenantsGetter has a method to return a A0TenantInterface.
+// A group's client should implement this interface.
+type A0TenantsGetter interface {
+	A0Tenants(namespace string) A0TenantInterface
+}
+
+// A0TenantInterface has methods to work with A0Tenant resources.
+type A0TenantInterface interface {
+	Create(ctx context.Context, a0Tenant *apiv1.A0Tenant, opts metav1.CreateOptions) (*apiv1.A0Tenant, error)
+	Update(ctx context.Context, a0Tenant *apiv1.A0Tenant, opts metav1.UpdateOptions) (*apiv1.A0Tenant, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, a0Tenant *apiv1.A0Tenant, opts metav1.UpdateOptions) (*apiv1.A0Tenant, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*apiv1.A0Tenant, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*apiv1.A0TenantList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *apiv1.A0Tenant, err error)
+	A0TenantExpansion
+}
+
+// a0Tenants implements A0TenantInterface
+type a0Tenants struct {
+	*gentype.ClientWithList[*apiv1.A0Tenant, *apiv1.A0TenantList]
+}
+
+// newA0Tenants returns a A0Tenants
+func newA0Tenants(c *KubernetesV1Client, namespace string) *a0Tenants {
+	return &a0Tenants{
+		gentype.NewClientWithList[*apiv1.A0Tenant, *apiv1.A0TenantList](
+			"a0tenants",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1.A0Tenant { return &apiv1.A0Tenant{} },
+			func() *apiv1.A0TenantList { return &apiv1.A0TenantList{} },
+		),
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	http "net/http"
+
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	scheme "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+type KubernetesV1Interface interface {
+	RESTClient() rest.Interface
+	A0ClientsGetter
+	A0ClientGrantsGetter
+	A0ConnectionsGetter
+	A0ResourceServersGetter
+	A0TenantsGetter
+}
+
+// KubernetesV1Client is used to interact with features provided by the kubernetes.auth0.com group.
+type KubernetesV1Client struct {
+	restClient rest.Interface
+}
+
+func (c *KubernetesV1Client) A0Clients(namespace string) A0ClientInterface {
+	return newA0Clients(c, namespace)
+}
+
+func (c *KubernetesV1Client) A0ClientGrants(namespace string) A0ClientGrantInterface {
+	return newA0ClientGrants(c, namespace)
+}
+
+func (c *KubernetesV1Client) A0Connections(namespace string) A0ConnectionInterface {
+	return newA0Connections(c, namespace)
+}
+
+func (c *KubernetesV1Client) A0ResourceServers(namespace string) A0ResourceServerInterface {
+	return newA0ResourceServers(c, namespace)
+}
+
+func (c *KubernetesV1Client) A0Tenants(namespace string) A0TenantInterface {
+	return newA0Tenants(c, namespace)
+}
+
+// NewForConfig creates a new KubernetesV1Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*KubernetesV1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new KubernetesV1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*KubernetesV1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &KubernetesV1Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new KubernetesV1Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *KubernetesV1Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new KubernetesV1Client for the given RESTClient.
+func New(c rest.Interface) *KubernetesV1Client {
+	return &KubernetesV1Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := apiv1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = rest.CodecFactoryForGeneratedClient(scheme.Scheme, scheme.Codecs).WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *KubernetesV1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/typed/api/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeA0Clients implements A0ClientInterface
+type fakeA0Clients struct {
+	*gentype.FakeClientWithList[*v1.A0Client, *v1.A0ClientList]
+	Fake *FakeKubernetesV1
+}
+
+func newFakeA0Clients(fake *FakeKubernetesV1, namespace string) apiv1.A0ClientInterface {
+	return &fakeA0Clients{
+		gentype.NewFakeClientWithList[*v1.A0Client, *v1.A0ClientList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("a0clients"),
+			v1.SchemeGroupVersion.WithKind("A0Client"),
+			func() *v1.A0Client { return &v1.A0Client{} },
+			func() *v1.A0ClientList { return &v1.A0ClientList{} },
+			func(dst, src *v1.A0ClientList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.A0ClientList) []*v1.A0Client { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.A0ClientList, items []*v1.A0Client) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
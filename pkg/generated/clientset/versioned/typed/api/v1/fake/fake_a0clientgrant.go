@@ -0,0 +1,49 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/typed/api/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeA0ClientGrants implements A0ClientGrantInterface
+type fakeA0ClientGrants struct {
+	*gentype.FakeClientWithList[*v1.A0ClientGrant, *v1.A0ClientGrantList]
+	Fake *FakeKubernetesV1
+}
+
+func newFakeA0ClientGrants(fake *FakeKubernetesV1, namespace string) apiv1.A0ClientGrantInterface {
+	return &fakeA0ClientGrants{
+		gentype.NewFakeClientWithList[*v1.A0ClientGrant, *v1.A0ClientGrantList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("a0clientgrants"),
+			v1.SchemeGroupVersion.WithKind("A0ClientGrant"),
+			func() *v1.A0ClientGrant { return &v1.A0ClientGrant{} },
+			func() *v1.A0ClientGrantList { return &v1.A0ClientGrantList{} },
+			func(dst, src *v1.A0ClientGrantList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.A0ClientGrantList) []*v1.A0ClientGrant { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.A0ClientGrantList, items []*v1.A0ClientGrant) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
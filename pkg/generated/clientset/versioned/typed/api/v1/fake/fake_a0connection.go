@@ -0,0 +1,49 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/typed/api/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeA0Connections implements A0ConnectionInterface
+type fakeA0Connections struct {
+	*gentype.FakeClientWithList[*v1.A0Connection, *v1.A0ConnectionList]
+	Fake *FakeKubernetesV1
+}
+
+func newFakeA0Connections(fake *FakeKubernetesV1, namespace string) apiv1.A0ConnectionInterface {
+	return &fakeA0Connections{
+		gentype.NewFakeClientWithList[*v1.A0Connection, *v1.A0ConnectionList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("a0connections"),
+			v1.SchemeGroupVersion.WithKind("A0Connection"),
+			func() *v1.A0Connection { return &v1.A0Connection{} },
+			func() *v1.A0ConnectionList { return &v1.A0ConnectionList{} },
+			func(dst, src *v1.A0ConnectionList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.A0ConnectionList) []*v1.A0Connection { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.A0ConnectionList, items []*v1.A0Connection) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
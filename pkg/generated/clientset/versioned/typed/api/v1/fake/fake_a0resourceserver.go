@@ -0,0 +1,49 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/typed/api/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeA0ResourceServers implements A0ResourceServerInterface
+type fakeA0ResourceServers struct {
+	*gentype.FakeClientWithList[*v1.A0ResourceServer, *v1.A0ResourceServerList]
+	Fake *FakeKubernetesV1
+}
+
+func newFakeA0ResourceServers(fake *FakeKubernetesV1, namespace string) apiv1.A0ResourceServerInterface {
+	return &fakeA0ResourceServers{
+		gentype.NewFakeClientWithList[*v1.A0ResourceServer, *v1.A0ResourceServerList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("a0resourceservers"),
+			v1.SchemeGroupVersion.WithKind("A0ResourceServer"),
+			func() *v1.A0ResourceServer { return &v1.A0ResourceServer{} },
+			func() *v1.A0ResourceServerList { return &v1.A0ResourceServerList{} },
+			func(dst, src *v1.A0ResourceServerList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.A0ResourceServerList) []*v1.A0ResourceServer { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.A0ResourceServerList, items []*v1.A0ResourceServer) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/typed/api/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeA0Tenants implements A0TenantInterface
+type fakeA0Tenants struct {
+	*gentype.FakeClientWithList[*v1.A0Tenant, *v1.A0TenantList]
+	Fake *FakeKubernetesV1
+}
+
+func newFakeA0Tenants(fake *FakeKubernetesV1, namespace string) apiv1.A0TenantInterface {
+	return &fakeA0Tenants{
+		gentype.NewFakeClientWithList[*v1.A0Tenant, *v1.A0TenantList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("a0tenants"),
+			v1.SchemeGroupVersion.WithKind("A0Tenant"),
+			func() *v1.A0Tenant { return &v1.A0Tenant{} },
+			func() *v1.A0TenantList { return &v1.A0TenantList{} },
+			func(dst, src *v1.A0TenantList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.A0TenantList) []*v1.A0Tenant { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.A0TenantList, items []*v1.A0Tenant) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
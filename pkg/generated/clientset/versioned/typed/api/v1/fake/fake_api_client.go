@@ -0,0 +1,55 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned/typed/api/v1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeKubernetesV1 struct {
+	*testing.Fake
+}
+
+func (c *FakeKubernetesV1) A0Clients(namespace string) v1.A0ClientInterface {
+	return newFakeA0Clients(c, namespace)
+}
+
+func (c *FakeKubernetesV1) A0ClientGrants(namespace string) v1.A0ClientGrantInterface {
+	return newFakeA0ClientGrants(c, namespace)
+}
+
+func (c *FakeKubernetesV1) A0Connections(namespace string) v1.A0ConnectionInterface {
+	return newFakeA0Connections(c, namespace)
+}
+
+func (c *FakeKubernetesV1) A0ResourceServers(namespace string) v1.A0ResourceServerInterface {
+	return newFakeA0ResourceServers(c, namespace)
+}
+
+func (c *FakeKubernetesV1) A0Tenants(namespace string) v1.A0TenantInterface {
+	return newFakeA0Tenants(c, namespace)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeKubernetesV1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	time "time"
+
+	auth0operatorapiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	versioned "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/seatgeek/auth0-operator/pkg/generated/informers/externalversions/internalinterfaces"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/listers/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0ConnectionInformer provides access to a shared informer and lister for
+// A0Connections.
+type A0ConnectionInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1.A0ConnectionLister
+}
+
+type a0ConnectionInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewA0ConnectionInformer constructs a new informer for A0Connection type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewA0ConnectionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredA0ConnectionInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredA0ConnectionInformer constructs a new informer for A0Connection type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredA0ConnectionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KubernetesV1().A0Connections(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KubernetesV1().A0Connections(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&auth0operatorapiv1.A0Connection{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *a0ConnectionInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredA0ConnectionInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *a0ConnectionInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&auth0operatorapiv1.A0Connection{}, f.defaultInformer)
+}
+
+func (f *a0ConnectionInformer) Lister() apiv1.A0ConnectionLister {
+	return apiv1.NewA0ConnectionLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	time "time"
+
+	auth0operatorapiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	versioned "github.com/seatgeek/auth0-operator/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/seatgeek/auth0-operator/pkg/generated/informers/externalversions/internalinterfaces"
+	apiv1 "github.com/seatgeek/auth0-operator/pkg/generated/listers/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0ResourceServerInformer provides access to a shared informer and lister for
+// A0ResourceServers.
+type A0ResourceServerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1.A0ResourceServerLister
+}
+
+type a0ResourceServerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewA0ResourceServerInformer constructs a new informer for A0ResourceServer type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewA0ResourceServerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredA0ResourceServerInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredA0ResourceServerInformer constructs a new informer for A0ResourceServer type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredA0ResourceServerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KubernetesV1().A0ResourceServers(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KubernetesV1().A0ResourceServers(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&auth0operatorapiv1.A0ResourceServer{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *a0ResourceServerInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredA0ResourceServerInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *a0ResourceServerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&auth0operatorapiv1.A0ResourceServer{}, f.defaultInformer)
+}
+
+func (f *a0ResourceServerInformer) Lister() apiv1.A0ResourceServerLister {
+	return apiv1.NewA0ResourceServerLister(f.Informer().GetIndexer())
+}
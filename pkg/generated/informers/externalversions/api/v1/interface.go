@@ -0,0 +1,72 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	internalinterfaces "github.com/seatgeek/auth0-operator/pkg/generated/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// A0Clients returns a A0ClientInformer.
+	A0Clients() A0ClientInformer
+	// A0ClientGrants returns a A0ClientGrantInformer.
+	A0ClientGrants() A0ClientGrantInformer
+	// A0Connections returns a A0ConnectionInformer.
+	A0Connections() A0ConnectionInformer
+	// A0ResourceServers returns a A0ResourceServerInformer.
+	A0ResourceServers() A0ResourceServerInformer
+	// A0Tenants returns a A0TenantInformer.
+	A0Tenants() A0TenantInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// A0Clients returns a A0ClientInformer.
+func (v *version) A0Clients() A0ClientInformer {
+	return &a0ClientInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// A0ClientGrants returns a A0ClientGrantInformer.
+func (v *version) A0ClientGrants() A0ClientGrantInformer {
+	return &a0ClientGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// A0Connections returns a A0ConnectionInformer.
+func (v *version) A0Connections() A0ConnectionInformer {
+	return &a0ConnectionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// A0ResourceServers returns a A0ResourceServerInformer.
+func (v *version) A0ResourceServers() A0ResourceServerInformer {
+	return &a0ResourceServerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// A0Tenants returns a A0TenantInformer.
+func (v *version) A0Tenants() A0TenantInformer {
+	return &a0TenantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
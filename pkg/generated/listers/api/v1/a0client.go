@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0ClientLister helps list A0Clients.
+// All objects returned here must be treated as read-only.
+type A0ClientLister interface {
+	// List lists all A0Clients in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0Client, err error)
+	// A0Clients returns an object that can list and get A0Clients.
+	A0Clients(namespace string) A0ClientNamespaceLister
+	A0ClientListerExpansion
+}
+
+// a0ClientLister implements the A0ClientLister interface.
+type a0ClientLister struct {
+	listers.ResourceIndexer[*apiv1.A0Client]
+}
+
+// NewA0ClientLister returns a new A0ClientLister.
+func NewA0ClientLister(indexer cache.Indexer) A0ClientLister {
+	return &a0ClientLister{listers.New[*apiv1.A0Client](indexer, apiv1.Resource("a0client"))}
+}
+
+// A0Clients returns an object that can list and get A0Clients.
+func (s *a0ClientLister) A0Clients(namespace string) A0ClientNamespaceLister {
+	return a0ClientNamespaceLister{listers.NewNamespaced[*apiv1.A0Client](s.ResourceIndexer, namespace)}
+}
+
+// A0ClientNamespaceLister helps list and get A0Clients.
+// All objects returned here must be treated as read-only.
+type A0ClientNamespaceLister interface {
+	// List lists all A0Clients in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0Client, err error)
+	// Get retrieves the A0Client from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1.A0Client, error)
+	A0ClientNamespaceListerExpansion
+}
+
+// a0ClientNamespaceLister implements the A0ClientNamespaceLister
+// interface.
+type a0ClientNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1.A0Client]
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0ClientGrantLister helps list A0ClientGrants.
+// All objects returned here must be treated as read-only.
+type A0ClientGrantLister interface {
+	// List lists all A0ClientGrants in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0ClientGrant, err error)
+	// A0ClientGrants returns an object that can list and get A0ClientGrants.
+	A0ClientGrants(namespace string) A0ClientGrantNamespaceLister
+	A0ClientGrantListerExpansion
+}
+
+// a0ClientGrantLister implements the A0ClientGrantLister interface.
+type a0ClientGrantLister struct {
+	listers.ResourceIndexer[*apiv1.A0ClientGrant]
+}
+
+// NewA0ClientGrantLister returns a new A0ClientGrantLister.
+func NewA0ClientGrantLister(indexer cache.Indexer) A0ClientGrantLister {
+	return &a0ClientGrantLister{listers.New[*apiv1.A0ClientGrant](indexer, apiv1.Resource("a0clientgrant"))}
+}
+
+// A0ClientGrants returns an object that can list and get A0ClientGrants.
+func (s *a0ClientGrantLister) A0ClientGrants(namespace string) A0ClientGrantNamespaceLister {
+	return a0ClientGrantNamespaceLister{listers.NewNamespaced[*apiv1.A0ClientGrant](s.ResourceIndexer, namespace)}
+}
+
+// A0ClientGrantNamespaceLister helps list and get A0ClientGrants.
+// All objects returned here must be treated as read-only.
+type A0ClientGrantNamespaceLister interface {
+	// List lists all A0ClientGrants in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0ClientGrant, err error)
+	// Get retrieves the A0ClientGrant from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1.A0ClientGrant, error)
+	A0ClientGrantNamespaceListerExpansion
+}
+
+// a0ClientGrantNamespaceLister implements the A0ClientGrantNamespaceLister
+// interface.
+type a0ClientGrantNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1.A0ClientGrant]
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0ConnectionLister helps list A0Connections.
+// All objects returned here must be treated as read-only.
+type A0ConnectionLister interface {
+	// List lists all A0Connections in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0Connection, err error)
+	// A0Connections returns an object that can list and get A0Connections.
+	A0Connections(namespace string) A0ConnectionNamespaceLister
+	A0ConnectionListerExpansion
+}
+
+// a0ConnectionLister implements the A0ConnectionLister interface.
+type a0ConnectionLister struct {
+	listers.ResourceIndexer[*apiv1.A0Connection]
+}
+
+// NewA0ConnectionLister returns a new A0ConnectionLister.
+func NewA0ConnectionLister(indexer cache.Indexer) A0ConnectionLister {
+	return &a0ConnectionLister{listers.New[*apiv1.A0Connection](indexer, apiv1.Resource("a0connection"))}
+}
+
+// A0Connections returns an object that can list and get A0Connections.
+func (s *a0ConnectionLister) A0Connections(namespace string) A0ConnectionNamespaceLister {
+	return a0ConnectionNamespaceLister{listers.NewNamespaced[*apiv1.A0Connection](s.ResourceIndexer, namespace)}
+}
+
+// A0ConnectionNamespaceLister helps list and get A0Connections.
+// All objects returned here must be treated as read-only.
+type A0ConnectionNamespaceLister interface {
+	// List lists all A0Connections in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0Connection, err error)
+	// Get retrieves the A0Connection from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1.A0Connection, error)
+	A0ConnectionNamespaceListerExpansion
+}
+
+// a0ConnectionNamespaceLister implements the A0ConnectionNamespaceLister
+// interface.
+type a0ConnectionNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1.A0Connection]
+}
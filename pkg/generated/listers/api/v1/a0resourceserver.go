@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0ResourceServerLister helps list A0ResourceServers.
+// All objects returned here must be treated as read-only.
+type A0ResourceServerLister interface {
+	// List lists all A0ResourceServers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0ResourceServer, err error)
+	// A0ResourceServers returns an object that can list and get A0ResourceServers.
+	A0ResourceServers(namespace string) A0ResourceServerNamespaceLister
+	A0ResourceServerListerExpansion
+}
+
+// a0ResourceServerLister implements the A0ResourceServerLister interface.
+type a0ResourceServerLister struct {
+	listers.ResourceIndexer[*apiv1.A0ResourceServer]
+}
+
+// NewA0ResourceServerLister returns a new A0ResourceServerLister.
+func NewA0ResourceServerLister(indexer cache.Indexer) A0ResourceServerLister {
+	return &a0ResourceServerLister{listers.New[*apiv1.A0ResourceServer](indexer, apiv1.Resource("a0resourceserver"))}
+}
+
+// A0ResourceServers returns an object that can list and get A0ResourceServers.
+func (s *a0ResourceServerLister) A0ResourceServers(namespace string) A0ResourceServerNamespaceLister {
+	return a0ResourceServerNamespaceLister{listers.NewNamespaced[*apiv1.A0ResourceServer](s.ResourceIndexer, namespace)}
+}
+
+// A0ResourceServerNamespaceLister helps list and get A0ResourceServers.
+// All objects returned here must be treated as read-only.
+type A0ResourceServerNamespaceLister interface {
+	// List lists all A0ResourceServers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0ResourceServer, err error)
+	// Get retrieves the A0ResourceServer from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1.A0ResourceServer, error)
+	A0ResourceServerNamespaceListerExpansion
+}
+
+// a0ResourceServerNamespaceLister implements the A0ResourceServerNamespaceLister
+// interface.
+type a0ResourceServerNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1.A0ResourceServer]
+}
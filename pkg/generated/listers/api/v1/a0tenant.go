@@ -0,0 +1,69 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	apiv1 "github.com/seatgeek/auth0-operator/api/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// A0TenantLister helps list A0Tenants.
+// All objects returned here must be treated as read-only.
+type A0TenantLister interface {
+	// List lists all A0Tenants in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0Tenant, err error)
+	// A0Tenants returns an object that can list and get A0Tenants.
+	A0Tenants(namespace string) A0TenantNamespaceLister
+	A0TenantListerExpansion
+}
+
+// a0TenantLister implements the A0TenantLister interface.
+type a0TenantLister struct {
+	listers.ResourceIndexer[*apiv1.A0Tenant]
+}
+
+// NewA0TenantLister returns a new A0TenantLister.
+func NewA0TenantLister(indexer cache.Indexer) A0TenantLister {
+	return &a0TenantLister{listers.New[*apiv1.A0Tenant](indexer, apiv1.Resource("a0tenant"))}
+}
+
+// A0Tenants returns an object that can list and get A0Tenants.
+func (s *a0TenantLister) A0Tenants(namespace string) A0TenantNamespaceLister {
+	return a0TenantNamespaceLister{listers.NewNamespaced[*apiv1.A0Tenant](s.ResourceIndexer, namespace)}
+}
+
+// A0TenantNamespaceLister helps list and get A0Tenants.
+// All objects returned here must be treated as read-only.
+type A0TenantNamespaceLister interface {
+	// List lists all A0Tenants in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1.A0Tenant, err error)
+	// Get retrieves the A0Tenant from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1.A0Tenant, error)
+	A0TenantNamespaceListerExpansion
+}
+
+// a0TenantNamespaceLister implements the A0TenantNamespaceLister
+// interface.
+type a0TenantNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1.A0Tenant]
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 SeatGeek.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+// A0ClientListerExpansion allows custom methods to be added to
+// A0ClientLister.
+type A0ClientListerExpansion interface{}
+
+// A0ClientNamespaceListerExpansion allows custom methods to be added to
+// A0ClientNamespaceLister.
+type A0ClientNamespaceListerExpansion interface{}
+
+// A0ClientGrantListerExpansion allows custom methods to be added to
+// A0ClientGrantLister.
+type A0ClientGrantListerExpansion interface{}
+
+// A0ClientGrantNamespaceListerExpansion allows custom methods to be added to
+// A0ClientGrantNamespaceLister.
+type A0ClientGrantNamespaceListerExpansion interface{}
+
+// A0ConnectionListerExpansion allows custom methods to be added to
+// A0ConnectionLister.
+type A0ConnectionListerExpansion interface{}
+
+// A0ConnectionNamespaceListerExpansion allows custom methods to be added to
+// A0ConnectionNamespaceLister.
+type A0ConnectionNamespaceListerExpansion interface{}
+
+// A0ResourceServerListerExpansion allows custom methods to be added to
+// A0ResourceServerLister.
+type A0ResourceServerListerExpansion interface{}
+
+// A0ResourceServerNamespaceListerExpansion allows custom methods to be added to
+// A0ResourceServerNamespaceLister.
+type A0ResourceServerNamespaceListerExpansion interface{}
+
+// A0TenantListerExpansion allows custom methods to be added to
+// A0TenantLister.
+type A0TenantListerExpansion interface{}
+
+// A0TenantNamespaceListerExpansion allows custom methods to be added to
+// A0TenantNamespaceLister.
+type A0TenantNamespaceListerExpansion interface{}
@@ -0,0 +1,294 @@
+// Package importer generates A0* resources from the entities of an existing Auth0 tenant, so that tenants configured
+// before the operator was adopted can be brought under its management through a reviewable change.
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+// Options control the generated resources
+type Options struct {
+	// Namespace is the namespace of the generated resources
+	Namespace string
+
+	// TenantName is the name of the generated A0Tenant, referenced by every other resource
+	TenantName string
+
+	// Domain is the domain of the tenant, written to the A0Tenant
+	Domain string
+
+	// SecretName is the Secret with the Management API credentials of the tenant, written to the A0Tenant
+	SecretName string
+}
+
+// Result holds the generated resources
+type Result struct {
+	Tenant          *v1.A0Tenant
+	Clients         []*v1.A0Client
+	Connections     []*v1.A0Connection
+	ResourceServers []*v1.A0ResourceServer
+	ClientGrants    []*v1.A0ClientGrant
+
+	// Warnings lists the fields that could not be mapped to the A0* types and are missing from the resources
+	Warnings []string
+}
+
+// Objects returns the generated resources in the order they depend on each other.
+func (r *Result) Objects() []client.Object {
+	var objs []client.Object
+	if r.Tenant != nil {
+		objs = append(objs, r.Tenant)
+	}
+	for _, c := range r.Connections {
+		objs = append(objs, c)
+	}
+	for _, rs := range r.ResourceServers {
+		objs = append(objs, rs)
+	}
+	for _, c := range r.Clients {
+		objs = append(objs, c)
+	}
+	for _, g := range r.ClientGrants {
+		objs = append(objs, g)
+	}
+	return objs
+}
+
+// readOnlyFields are the fields of each collection that Auth0 manages and that are left out of the generated Conf
+var readOnlyFields = map[string][]string{
+	"clients":          {"client_id", "client_secret", "signing_keys", "tenant", "global", "callback_url_template", "owners"},
+	"connections":      {"id", "enabled_clients"},
+	"resource-servers": {"id", "is_system", "signing_secret"},
+}
+
+// Import reads the clients, connections, resource servers, client grants and settings of the tenant of api and
+// returns them as A0* resources. Clients and connections are found by their Auth0 ID, and references between the
+// resources use the generated names. The global client and system APIs such as the Management API are skipped.
+func Import(ctx context.Context, api *management.Client, opts Options) (*Result, error) {
+	if opts.TenantName == "" {
+		return nil, fmt.Errorf("a tenant name is required")
+	}
+	r := &Result{}
+	names := manifest.NewNamer()
+	tenantRef := &v1.V1TenantReference{Name: opts.TenantName}
+
+	settings, err := api.GetTenantSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tenant settings: %w", err)
+	}
+	tenantConf := &v1.TenantConf{}
+	r.decode("tenant settings", settings, tenantConf)
+	domain := opts.Domain
+	r.Tenant = &v1.A0Tenant{
+		TypeMeta:   typeMeta("A0Tenant"),
+		ObjectMeta: objectMeta(opts.TenantName, opts.Namespace),
+		Spec: v1.A0TenantSpec{
+			Name: opts.TenantName,
+			Auth: &v1.TenantAuth{Domain: &domain, SecretRef: &v1.V1SecretReference{Name: opts.SecretName, Namespace: opts.Namespace}},
+			Conf: tenantConf,
+		},
+	}
+
+	connections, err := api.ListConnections(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+	connectionNames := map[string]string{}
+	for _, obj := range sortByName(connections) {
+		id, _ := obj["id"].(string)
+		name := names.Next("connection", obj["name"], id)
+		connectionNames[id] = name
+		conf := &v1.ConnectionConf{}
+		r.decode("connection "+name, without(obj, readOnlyFields["connections"]...), conf)
+		r.Connections = append(r.Connections, &v1.A0Connection{
+			TypeMeta:   typeMeta("A0Connection"),
+			ObjectMeta: objectMeta(name, opts.Namespace),
+			Spec: v1.A0ConnectionSpec{
+				TenantRef: tenantRef,
+				Find:      &v1.ConnectionFind{ConnectionId: &id},
+				Conf:      conf,
+			},
+		})
+	}
+
+	resourceServers, err := api.ListResourceServers(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource servers: %w", err)
+	}
+	audienceNames := map[string]string{}
+	for _, obj := range sortByName(resourceServers) {
+		if system, _ := obj["is_system"].(bool); system {
+			continue
+		}
+		identifier, _ := obj["identifier"].(string)
+		name := names.Next("resourceserver", obj["name"], identifier)
+		audienceNames[strings.ToLower(identifier)] = name
+		if _, ok := obj["signing_secret"]; ok {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("resource server %s: signing_secret was not imported, Auth0 keeps the current secret", name))
+		}
+		conf := &v1.ResourceServerConf{}
+		r.decode("resource server "+name, without(obj, readOnlyFields["resource-servers"]...), conf)
+		r.ResourceServers = append(r.ResourceServers, &v1.A0ResourceServer{
+			TypeMeta:   typeMeta("A0ResourceServer"),
+			ObjectMeta: objectMeta(name, opts.Namespace),
+			Spec:       v1.A0ResourceServerSpec{TenantRef: tenantRef, Conf: conf},
+		})
+	}
+
+	clients, err := api.ListClients(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clients: %w", err)
+	}
+	clientNames := map[string]string{}
+	for _, obj := range sortByName(clients) {
+		if global, _ := obj["global"].(bool); global {
+			continue
+		}
+		id, _ := obj["client_id"].(string)
+		name := names.Next("client", obj["name"], id)
+		clientNames[id] = name
+		conf := &v1.ClientConf{}
+		r.decode("client "+name, without(obj, readOnlyFields["clients"]...), conf)
+
+		enabled, err := api.ListClientConnections(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the connections of client %s: %w", id, err)
+		}
+		for _, connection := range enabled {
+			connectionID, _ := connection["id"].(string)
+			if n, ok := connectionNames[connectionID]; ok {
+				conf.EnabledConnections = append(conf.EnabledConnections, v1.V1ConnectionReference{Name: &n})
+			} else {
+				conf.EnabledConnections = append(conf.EnabledConnections, v1.V1ConnectionReference{Id: &connectionID})
+			}
+		}
+		sort.Slice(conf.EnabledConnections, func(i, j int) bool {
+			return refName(conf.EnabledConnections[i].Name, conf.EnabledConnections[i].Id) < refName(conf.EnabledConnections[j].Name, conf.EnabledConnections[j].Id)
+		})
+
+		r.Clients = append(r.Clients, &v1.A0Client{
+			TypeMeta:   typeMeta("A0Client"),
+			ObjectMeta: objectMeta(name, opts.Namespace),
+			Spec: v1.A0ClientSpec{
+				TenantRef: tenantRef,
+				Find:      &v1.ClientFind{ClientId: &id},
+				Conf:      conf,
+			},
+		})
+	}
+
+	grants, err := api.ListClientGrants(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client grants: %w", err)
+	}
+	for _, obj := range grants {
+		clientID, _ := obj["client_id"].(string)
+		audience, _ := obj["audience"].(string)
+		conf := &v1.ClientGrantConf{}
+		r.decode("client grant "+clientID+" "+audience, management.Object{"scope": obj["scope"]}, conf)
+
+		clientName, audienceName := clientNames[clientID], audienceNames[strings.ToLower(audience)]
+		if clientName != "" {
+			conf.ClientRef = &v1.V1ClientReference{Name: &clientName}
+		} else {
+			conf.ClientRef = &v1.V1ClientReference{Id: &clientID}
+		}
+		if audienceName != "" {
+			conf.Audience = &v1.V1ResourceServerReference{Name: &audienceName}
+		} else {
+			conf.Audience = &v1.V1ResourceServerReference{Identifier: &audience}
+		}
+
+		name := names.Next("clientgrant", firstNonEmpty(clientName, clientID)+"-"+firstNonEmpty(audienceName, audience), "")
+		r.ClientGrants = append(r.ClientGrants, &v1.A0ClientGrant{
+			TypeMeta:   typeMeta("A0ClientGrant"),
+			ObjectMeta: objectMeta(name, opts.Namespace),
+			Spec:       v1.A0ClientGrantSpec{TenantRef: tenantRef, Conf: conf},
+		})
+	}
+	sort.Slice(r.ClientGrants, func(i, j int) bool { return r.ClientGrants[i].Name < r.ClientGrants[j].Name })
+
+	return r, nil
+}
+
+// decode maps the fields of obj to conf one at a time, so that a field the A0* types cannot represent is reported
+// as a warning instead of failing the import.
+func (r *Result) decode(what string, obj management.Object, conf interface{}) {
+	for _, key := range sortedKeys(obj) {
+		data, err := json.Marshal(map[string]interface{}{key: obj[key]})
+		if err == nil {
+			err = json.Unmarshal(data, conf)
+		}
+		if err != nil {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("%s: %s was not imported: %v", what, key, err))
+		}
+	}
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: v1.GroupVersion.String(), Kind: kind}
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: namespace}
+}
+
+// sortByName orders entities by name, so that generated names are stable between imports.
+func sortByName(objs []management.Object) []management.Object {
+	sort.SliceStable(objs, func(i, j int) bool {
+		a, _ := objs[i]["name"].(string)
+		b, _ := objs[j]["name"].(string)
+		return a < b
+	})
+	return objs
+}
+
+func without(obj management.Object, fields ...string) management.Object {
+	out := make(management.Object, len(obj))
+	for k, v := range obj {
+		out[k] = v
+	}
+	for _, f := range fields {
+		delete(out, f)
+	}
+	return out
+}
+
+func sortedKeys(obj management.Object) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func refName(name, id *string) string {
+	if name != nil {
+		return *name
+	}
+	if id != nil {
+		return *id
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+package importer_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/importer"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+func newClient(t *testing.T, s *fake.Server) *management.Client {
+	t.Helper()
+	access := management.NewTenantApiAccess(management.Credentials{
+		Domain:       s.Domain(),
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+	}, management.WithHTTPClient(s.Client()))
+	return management.NewClient(access, s.Client())
+}
+
+func TestImport(t *testing.T) {
+	s := fake.NewUnstartedServer(fake.WithTenantSettings(fake.Object{"friendly_name": "Example", "enabled_locales": []interface{}{"en"}}))
+	s.StartTLS()
+	t.Cleanup(s.Close)
+
+	webID := s.Seed(fake.Clients, fake.Object{"name": "My Web App", "app_type": "regular_web", "client_secret": "s3cr3t", "callbacks": []interface{}{"https://example.com/cb"}})
+	s.Seed(fake.Clients, fake.Object{"name": "All Applications", "global": true})
+	s.Seed(fake.Clients, fake.Object{"name": "my web app", "app_type": "spa", "jwt_configuration": "not an object"})
+	s.Seed(fake.Connections, fake.Object{"id": "con_1", "name": "Username-Password-Authentication", "strategy": "auth0", "enabled_clients": []interface{}{webID}})
+	s.Seed(fake.ResourceServers, fake.Object{"name": "Auth0 Management API", "identifier": "https://" + s.Domain() + "/api/v2/", "is_system": true})
+	s.Seed(fake.ResourceServers, fake.Object{"name": "Things API", "identifier": "https://api.example.com", "signing_alg": "HS256", "signing_secret": "hm4c", "scopes": []interface{}{fake.Object{"value": "read:things"}}})
+	s.Seed(fake.ClientGrants, fake.Object{"client_id": webID, "audience": "https://api.example.com", "scope": []interface{}{"read:things"}})
+	s.Seed(fake.ClientGrants, fake.Object{"client_id": webID, "audience": "https://" + s.Domain() + "/api/v2/", "scope": []interface{}{"read:users"}})
+
+	result, err := importer.Import(context.Background(), newClient(t, s), importer.Options{Namespace: "auth0", TenantName: "example", Domain: s.Domain(), SecretName: "example-auth0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := *result.Tenant.Spec.Conf.FriendlyName; got != "Example" {
+		t.Errorf("expected the tenant settings in the A0Tenant, got friendly_name %q", got)
+	}
+
+	if len(result.Clients) != 2 {
+		t.Fatalf("expected the global client to be skipped, got %d clients", len(result.Clients))
+	}
+	web := result.Clients[0]
+	if web.Name != "my-web-app" || *web.Spec.Find.ClientId != webID || web.Spec.TenantRef.Name != "example" {
+		t.Errorf("unexpected client %s with find %+v", web.Name, web.Spec.Find)
+	}
+	if refs := web.Spec.Conf.EnabledConnections; len(refs) != 1 || *refs[0].Name != "username-password-authentication" {
+		t.Errorf("expected the connection to be referenced by name, got %+v", refs)
+	}
+	if other := result.Clients[1]; other.Name != "my-web-app-2" {
+		t.Errorf("expected a unique name for a client with the same name, got %s", other.Name)
+	}
+	if len(result.Warnings) != 2 || !strings.Contains(result.Warnings[0], "signing_secret") || !strings.Contains(result.Warnings[1], "jwt_configuration") {
+		t.Errorf("expected warnings for signing_secret and jwt_configuration, got %v", result.Warnings)
+	}
+
+	if len(result.ResourceServers) != 1 || result.ResourceServers[0].Name != "things-api" {
+		t.Fatalf("expected the system API to be skipped, got %d resource servers", len(result.ResourceServers))
+	}
+	if conf := result.ResourceServers[0].Spec.Conf; conf.SigningSecret != nil || *conf.SigningAlgorithm != "HS256" {
+		t.Errorf("expected the signing secret to be left out, got %+v", conf)
+	}
+	if len(result.Connections) != 1 || *result.Connections[0].Spec.Find.ConnectionId != "con_1" {
+		t.Fatalf("expected the connection to be found by id, got %+v", result.Connections)
+	}
+
+	grants := map[string]*v1.A0ClientGrant{}
+	for _, g := range result.ClientGrants {
+		grants[g.Name] = g
+	}
+	if g := grants["my-web-app-things-api"]; g == nil || *g.Spec.Conf.ClientRef.Name != "my-web-app" || *g.Spec.Conf.Audience.Name != "things-api" {
+		t.Errorf("expected a grant referencing the client and resource server by name, got %v", result.ClientGrants)
+	}
+	for _, g := range result.ClientGrants {
+		if g.Name != "my-web-app-things-api" && g.Spec.Conf.Audience.Identifier == nil {
+			t.Errorf("expected the Management API grant to keep its identifier, got %+v", g.Spec.Conf.Audience)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := manifest.Write(&out, result.Objects()); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"kind: A0Tenant", "apiVersion: kubernetes.auth0.com/v1", "client_id: " + webID, "namespace: auth0"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in the manifests:\n%s", want, out.String())
+		}
+	}
+	for _, unwanted := range []string{"s3cr3t", "status:", "creationTimestamp"} {
+		if strings.Contains(out.String(), unwanted) {
+			t.Errorf("unexpected %q in the manifests:\n%s", unwanted, out.String())
+		}
+	}
+}
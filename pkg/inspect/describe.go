@@ -0,0 +1,238 @@
+package inspect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// kinds maps the accepted spellings of each kind to an empty object of the kind
+var kinds = map[string]func() client.Object{
+	"a0tenant":         func() client.Object { return &v1.A0Tenant{} },
+	"a0client":         func() client.Object { return &v1.A0Client{} },
+	"a0connection":     func() client.Object { return &v1.A0Connection{} },
+	"a0clientgrant":    func() client.Object { return &v1.A0ClientGrant{} },
+	"a0resourceserver": func() client.Object { return &v1.A0ResourceServer{} },
+}
+
+// NewObject returns an empty object of kind, which is matched case-insensitively with or without the "A0" prefix
+// and a plural "s", e.g. "A0Client", "clients" or "clientgrant".
+func NewObject(kind string) (client.Object, error) {
+	k := strings.TrimSuffix(strings.ToLower(kind), "s")
+	if !strings.HasPrefix(k, "a0") {
+		k = "a0" + k
+	}
+	if f, ok := kinds[k]; ok {
+		return f(), nil
+	}
+	return nil, fmt.Errorf("unknown kind %q, expected one of A0Tenant, A0Client, A0Connection, A0ClientGrant or A0ResourceServer", kind)
+}
+
+// Describe writes the status of the resource of kind with key, its configuration with references resolved to Auth0
+// IDs the same way the operator resolves them, its Status.LastConf and the differences between both.
+func (i *Inspector) Describe(ctx context.Context, out io.Writer, kind string, key types.NamespacedName) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	defer w.Flush()
+
+	obj, err := NewObject(kind)
+	if err != nil {
+		return err
+	}
+	if err := i.Client.Get(ctx, key, obj); err != nil {
+		return err
+	}
+
+	var (
+		conf       interface{}
+		lastConf   *runtime.RawExtension
+		unresolved []string
+		ignored    []string
+	)
+	fmt.Fprintf(w, "Name:\t%s\n", key)
+	switch o := obj.(type) {
+	case *v1.A0Tenant:
+		fmt.Fprintf(w, "Kind:\tA0Tenant\n")
+		if o.Spec.Auth != nil {
+			fmt.Fprintf(w, "Domain:\t%s\n", deref(o.Spec.Auth.Domain))
+		}
+		fmt.Fprintf(w, "Policy:\t%s\n", policyString(o.Spec.Policy))
+		fmt.Fprintf(w, "Mode:\t%s\n", o.GetMode())
+		writeConditions(w, o)
+		writePlan(w, o.GetPlan())
+		if o.Spec.Conf != nil {
+			conf = o.Spec.Conf
+		}
+		lastConf = o.Status.LastConf
+	case v1.TenantEntity:
+		fmt.Fprintf(w, "Kind:\t%s\n", reflect.TypeOf(obj).Elem().Name())
+		if k, ok := tenantKey(o, key.Namespace); ok {
+			fmt.Fprintf(w, "Tenant:\t%s\n", k)
+		}
+		fmt.Fprintf(w, "Auth0 ID:\t%s\n", o.GetAuth0ID())
+		fmt.Fprintf(w, "Policy:\t%s\n", policyString(o.GetPolicy()))
+		fmt.Fprintf(w, "Mode:\t%s\n", o.GetMode())
+		fmt.Fprintf(w, "Drift:\t%s\n", o.GetDriftPolicy())
+		if ignored = o.GetIgnoreFields(); len(ignored) > 0 {
+			fmt.Fprintf(w, "Ignored:\t%s\n", strings.Join(ignored, ", "))
+		}
+		writeConditions(w, o)
+		writePlan(w, o.GetPlan())
+		if conf, unresolved, err = i.resolveConf(ctx, o, key.Namespace); err != nil {
+			return err
+		}
+		lastConf = o.GetLastConf()
+	}
+
+	fmt.Fprintln(w, "\nConf:")
+	if err := writeYAML(w, conf); err != nil {
+		return err
+	}
+	for _, u := range unresolved {
+		fmt.Fprintf(w, "  # unresolved: %s\n", u)
+	}
+
+	fmt.Fprintln(w, "\nLast Conf:")
+	var last management.Object
+	if lastConf != nil && len(lastConf.Raw) > 0 {
+		if err := json.Unmarshal(lastConf.Raw, &last); err != nil {
+			return fmt.Errorf("invalid lastConf: %w", err)
+		}
+		if err := writeYAML(w, last); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintln(w, "  <none>")
+	}
+
+	fmt.Fprintln(w, "\nDifferences:")
+	if conf == nil || last == nil {
+		fmt.Fprintln(w, "  <unknown>")
+		return nil
+	}
+	diffs, err := drift.CompareIgnoring(conf, last, ignored)
+	if err != nil {
+		return err
+	}
+	if len(diffs) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	}
+	for _, d := range diffs {
+		fmt.Fprintf(w, "  %s\n", d)
+	}
+	return nil
+}
+
+// resolveConf returns a copy of the configuration of entity with references by name replaced by the Auth0 IDs and
+// identifiers recorded in the status of the referenced resources, and the references that could not be resolved.
+func (i *Inspector) resolveConf(ctx context.Context, entity v1.TenantEntity, namespace string) (interface{}, []string, error) {
+	var unresolved []string
+	switch e := entity.(type) {
+	case *v1.A0Client:
+		if e.Spec.Conf == nil {
+			return nil, nil, nil
+		}
+		conf := e.Spec.Conf.DeepCopy()
+		for idx, ref := range conf.EnabledConnections {
+			if ref.Name == nil {
+				continue
+			}
+			target := refKey(ref.Namespace, *ref.Name, namespace)
+			connection := &v1.A0Connection{}
+			found, err := i.exists(ctx, target, connection)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !found || connection.GetAuth0ID() == "" {
+				unresolved = append(unresolved, fmt.Sprintf("enabled_connections[%d]: A0Connection %s has no id", idx, target))
+				continue
+			}
+			id := connection.GetAuth0ID()
+			conf.EnabledConnections[idx] = v1.V1ConnectionReference{Id: &id}
+		}
+		return conf, unresolved, nil
+	case *v1.A0ClientGrant:
+		if e.Spec.Conf == nil {
+			return nil, nil, nil
+		}
+		conf := e.Spec.Conf.DeepCopy()
+		if ref := conf.ClientRef; ref != nil && ref.Name != nil {
+			target := refKey(ref.Namespace, *ref.Name, namespace)
+			a0client := &v1.A0Client{}
+			found, err := i.exists(ctx, target, a0client)
+			if err != nil {
+				return nil, nil, err
+			}
+			if id := a0client.GetAuth0ID(); found && id != "" {
+				conf.ClientRef = &v1.V1ClientReference{Id: &id}
+			} else {
+				unresolved = append(unresolved, fmt.Sprintf("clientRef: A0Client %s has no id", target))
+			}
+		}
+		if ref := conf.Audience; ref != nil && ref.Identifier == nil && ref.Name != nil {
+			target := refKey(ref.Namespace, *ref.Name, namespace)
+			rs := &v1.A0ResourceServer{}
+			found, err := i.exists(ctx, target, rs)
+			if err != nil {
+				return nil, nil, err
+			}
+			if found && rs.Status.Identifier != nil {
+				conf.Audience = &v1.V1ResourceServerReference{Identifier: rs.Status.Identifier}
+			} else {
+				unresolved = append(unresolved, fmt.Sprintf("audience: A0ResourceServer %s has no identifier", target))
+			}
+		}
+		return conf, unresolved, nil
+	default:
+		return entity.GetConf(), nil, nil
+	}
+}
+
+func writeConditions(w io.Writer, obj v1.ConditionsAccessor) {
+	fmt.Fprintln(w, "Conditions:")
+	if len(obj.GetConditions()) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	}
+	for _, c := range obj.GetConditions() {
+		fmt.Fprintf(w, "  %s=%s\t%s\t%s\n", c.Type, c.Status, c.Reason, c.Message)
+	}
+}
+
+// writeYAML writes v as YAML indented by two spaces, or <none> when it is nil.
+// writePlan writes the plan recorded in Plan mode, if any.
+func writePlan(w io.Writer, p *v1.V1Plan) {
+	if p == nil {
+		return
+	}
+	fmt.Fprintln(w, "Plan:")
+	for _, line := range strings.Split(p.String(), "\n") {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+}
+
+func writeYAML(w io.Writer, v interface{}) error {
+	if v == nil {
+		fmt.Fprintln(w, "  <none>")
+		return nil
+	}
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Package inspect renders the A0* resources of a cluster and the relations between them, for the kubectl-auth0
+// plugin.
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// Inspector reads A0* resources with a Kubernetes client
+type Inspector struct {
+	Client client.Reader
+
+	// Namespace limits the listed resources to one namespace. All namespaces are listed when empty; references are
+	// followed into other namespaces either way.
+	Namespace string
+}
+
+// tenantEntity is a TenantEntity read with a controller-runtime client
+type tenantEntity interface {
+	v1.TenantEntity
+	client.Object
+}
+
+// inventory holds the A0* resources of the inspected namespaces
+type inventory struct {
+	tenants         []v1.A0Tenant
+	clients         []v1.A0Client
+	connections     []v1.A0Connection
+	grants          []v1.A0ClientGrant
+	resourceServers []v1.A0ResourceServer
+}
+
+func (i *Inspector) load(ctx context.Context) (*inventory, error) {
+	var (
+		tenants         v1.A0TenantList
+		clients         v1.A0ClientList
+		connections     v1.A0ConnectionList
+		grants          v1.A0ClientGrantList
+		resourceServers v1.A0ResourceServerList
+	)
+	for _, list := range []client.ObjectList{&tenants, &clients, &connections, &grants, &resourceServers} {
+		if err := i.Client.List(ctx, list, client.InNamespace(i.Namespace)); err != nil {
+			return nil, err
+		}
+	}
+	return &inventory{
+		tenants:         tenants.Items,
+		clients:         clients.Items,
+		connections:     connections.Items,
+		grants:          grants.Items,
+		resourceServers: resourceServers.Items,
+	}, nil
+}
+
+// exists reports whether obj can be read with key, returning false for a NotFound error.
+func (i *Inspector) exists(ctx context.Context, key types.NamespacedName, obj client.Object) (bool, error) {
+	if err := i.Client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// refKey returns the namespace/name of a reference, defaulting to the namespace of the referencing resource.
+func refKey(namespace *string, name string, defaultNamespace string) types.NamespacedName {
+	if namespace != nil && *namespace != "" {
+		return types.NamespacedName{Namespace: *namespace, Name: name}
+	}
+	return types.NamespacedName{Namespace: defaultNamespace, Name: name}
+}
+
+func tenantKey(entity v1.TenantEntity, namespace string) (types.NamespacedName, bool) {
+	ref := entity.GetTenantRef()
+	if ref == nil || ref.Name == "" {
+		return types.NamespacedName{}, false
+	}
+	return refKey(ref.Namespace, ref.Name, namespace), true
+}
+
+// status summarizes the Auth0 ID and readiness of a resource, e.g. "[abc123] Ready" or "[-] DependencyNotReady".
+func status(id string, obj v1.ConditionsAccessor) string {
+	if id == "" {
+		id = "-"
+	}
+	return fmt.Sprintf("[%s] %s", id, readiness(obj))
+}
+
+// readiness returns "Ready", the reason the resource is not ready, or "Unknown" before its first reconcile.
+func readiness(obj v1.ConditionsAccessor) string {
+	c := v1.GetCondition(obj, v1.ConditionTypeReady)
+	switch {
+	case c == nil:
+		return "Unknown"
+	case c.Status == metav1.ConditionTrue:
+		return "Ready"
+	default:
+		return c.Reason
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func policyString(policy []v1.V1EntityPolicyType) string {
+	names := make([]string, len(policy))
+	for i, p := range policy {
+		names[i] = string(p)
+	}
+	if len(names) == 0 {
+		return "<none>"
+	}
+	return strings.Join(names, ", ")
+}
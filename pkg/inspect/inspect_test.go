@@ -0,0 +1,159 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+	"github.com/seatgeek/auth0-operator/pkg/inspect"
+	"github.com/seatgeek/auth0-operator/pkg/manager"
+)
+
+func strPtr(s string) *string { return &s }
+
+func meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Namespace: "default", Name: name}
+}
+
+func tenantRef() *v1.V1TenantReference { return &v1.V1TenantReference{Name: "prod"} }
+
+func newInspector(objs ...client.Object) *inspect.Inspector {
+	kube := ctrlfake.NewClientBuilder().WithScheme(manager.Scheme()).WithObjects(objs...).Build()
+	return &inspect.Inspector{Client: kube, Namespace: "default"}
+}
+
+func fixtures() []client.Object {
+	return []client.Object{
+		&v1.A0Tenant{ObjectMeta: meta("prod"), Spec: v1.A0TenantSpec{Name: "prod", Auth: &v1.TenantAuth{Domain: strPtr("prod.auth0.com")}}},
+		&v1.A0Client{
+			ObjectMeta: meta("web"),
+			Spec: v1.A0ClientSpec{
+				TenantRef: tenantRef(),
+				SecretRef: &v1.V1SecretReference{Name: "web-credentials", Namespace: "default"},
+				Conf: &v1.ClientConf{
+					Name:               strPtr("Web"),
+					EnabledConnections: []v1.V1ConnectionReference{{Name: strPtr("db")}, {Name: strPtr("missing")}},
+				},
+			},
+			Status: v1.A0ClientStatus{
+				Id:       strPtr("abc"),
+				LastConf: &runtime.RawExtension{Raw: []byte(`{"client_id":"abc","name":"Old","enabled_connections":["con_1"]}`)},
+			},
+		},
+		&v1.A0Connection{ObjectMeta: meta("db"), Spec: v1.A0ConnectionSpec{TenantRef: tenantRef()}, Status: v1.A0ConnectionStatus{Id: strPtr("con_1")}},
+		&v1.A0ResourceServer{
+			ObjectMeta: meta("api"),
+			Spec:       v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: &v1.ResourceServerConf{Identifier: strPtr("https://api.example.com")}},
+		},
+		&v1.A0ClientGrant{
+			ObjectMeta: meta("web-api"),
+			Spec: v1.A0ClientGrantSpec{
+				TenantRef: tenantRef(),
+				Conf:      &v1.ClientGrantConf{ClientRef: &v1.V1ClientReference{Name: strPtr("web")}, Audience: &v1.V1ResourceServerReference{Name: strPtr("api")}},
+			},
+		},
+		&v1.A0ClientGrant{
+			ObjectMeta: meta("orphan"),
+			Spec: v1.A0ClientGrantSpec{
+				TenantRef: &v1.V1TenantReference{Name: "staging"},
+				Conf:      &v1.ClientGrantConf{ClientRef: &v1.V1ClientReference{Name: strPtr("gone")}, Audience: &v1.V1ResourceServerReference{Identifier: strPtr("https://x")}},
+			},
+		},
+	}
+}
+
+func TestTree(t *testing.T) {
+	var out bytes.Buffer
+	if err := newInspector(fixtures()...).Tree(context.Background(), &out); err != nil {
+		t.Fatal(err)
+	}
+	want := `A0Tenant default/prod (prod.auth0.com) Unknown
+├── A0Client default/web [abc] Unknown
+│   └── A0ClientGrant default/web-api [-] Unknown → A0ResourceServer default/api (https://api.example.com)
+├── A0Connection default/db [con_1] Unknown
+└── A0ResourceServer default/api [-] Unknown
+(unknown tenant)
+└── A0ClientGrant default/orphan [-] Unknown → https://x
+`
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	var out bytes.Buffer
+	if err := newInspector(fixtures()...).Describe(context.Background(), &out, "clients", types.NamespacedName{Namespace: "default", Name: "web"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"Kind:      A0Client",
+		"Auth0 ID:  abc",
+		"- id: con_1",
+		"# unresolved: enabled_connections[1]: A0Connection default/missing has no id",
+		`~ name: "Old" -> "Web"`,
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+
+	if _, err := inspect.NewObject("widgets"); err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+}
+
+func TestDanglingRefs(t *testing.T) {
+	dangling, err := newInspector(fixtures()...).DanglingRefs(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range dangling {
+		got = append(got, d.Key.Name+" "+d.Field+" "+d.TargetKind+" "+d.Target.String())
+	}
+	want := []string{
+		"web spec.conf.enabled_connections[1] A0Connection default/missing",
+		"orphan spec.tenantRef A0Tenant default/staging",
+		"orphan spec.conf.clientRef A0Client default/gone",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSecrets(t *testing.T) {
+	objs := append(fixtures(),
+		&v1.A0Client{ObjectMeta: meta("cli"), Spec: v1.A0ClientSpec{TenantRef: tenantRef(), SecretRef: &v1.V1SecretReference{Name: "shared", Namespace: "other"}}},
+		&v1.A0Client{ObjectMeta: meta("spa"), Spec: v1.A0ClientSpec{TenantRef: tenantRef(), SecretRef: &v1.V1SecretReference{Name: "spa", Namespace: "default"}}},
+		&v1.A0Client{ObjectMeta: meta("public"), Spec: v1.A0ClientSpec{TenantRef: tenantRef()}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "shared", Annotations: map[string]string{controller.ClientOwnerAnnotation: "default/cli"}},
+			Data:       map[string][]byte{"clientId": []byte("x"), "clientSecret": []byte("y")},
+		},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "spa"}},
+	)
+
+	var out bytes.Buffer
+	if err := newInspector(objs...).Secrets(context.Background(), &out); err != nil {
+		t.Fatal(err)
+	}
+	want := `CLIENT          SECRET                   STATE     KEYS
+default/cli     other/shared             Owned     clientId,clientSecret
+default/public  <none>                   -         -
+default/spa     default/spa              NotOwned  -
+default/web     default/web-credentials  Missing   -
+`
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
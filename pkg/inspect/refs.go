@@ -0,0 +1,166 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// DanglingRef is a reference by name to a resource that does not exist
+type DanglingRef struct {
+	// Kind and Key identify the referencing resource
+	Kind string
+	Key  types.NamespacedName
+
+	// Field is the path of the reference in the referencing resource, e.g. "spec.conf.enabled_connections[1]"
+	Field string
+
+	// TargetKind and Target identify the missing resource
+	TargetKind string
+	Target     types.NamespacedName
+}
+
+// reference is a reference by name found in a resource
+type reference struct {
+	field      string
+	targetKind string
+	target     types.NamespacedName
+	newObject  func() client.Object
+}
+
+// DanglingRefs returns the tenant, client, connection and resource server references by name whose target does not
+// exist. References by id or identifier point into Auth0 and are not checked.
+func (i *Inspector) DanglingRefs(ctx context.Context) ([]DanglingRef, error) {
+	inv, err := i.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dangling []DanglingRef
+	check := func(kind string, obj client.Object, refs []reference) error {
+		for _, ref := range refs {
+			found, err := i.exists(ctx, ref.target, ref.newObject())
+			if err != nil {
+				return err
+			}
+			if !found {
+				dangling = append(dangling, DanglingRef{
+					Kind:       kind,
+					Key:        client.ObjectKeyFromObject(obj),
+					Field:      ref.field,
+					TargetKind: ref.targetKind,
+					Target:     ref.target,
+				})
+			}
+		}
+		return nil
+	}
+
+	for idx := range inv.clients {
+		c := &inv.clients[idx]
+		refs := tenantRefs(c)
+		for _, part := range []struct {
+			field string
+			conf  *v1.ClientConf
+		}{{"spec.init", c.Spec.Init}, {"spec.conf", c.Spec.Conf}} {
+			if part.conf == nil {
+				continue
+			}
+			for n, ref := range part.conf.EnabledConnections {
+				if ref.Name != nil {
+					refs = append(refs, reference{
+						field:      fmt.Sprintf("%s.enabled_connections[%d]", part.field, n),
+						targetKind: "A0Connection",
+						target:     refKey(ref.Namespace, *ref.Name, c.Namespace),
+						newObject:  func() client.Object { return &v1.A0Connection{} },
+					})
+				}
+			}
+		}
+		if err := check("A0Client", c, refs); err != nil {
+			return nil, err
+		}
+	}
+
+	for idx := range inv.grants {
+		g := &inv.grants[idx]
+		refs := tenantRefs(g)
+		for _, part := range []struct {
+			field string
+			conf  *v1.ClientGrantConf
+		}{{"spec.init", g.Spec.Init}, {"spec.conf", g.Spec.Conf}} {
+			if part.conf == nil {
+				continue
+			}
+			if ref := part.conf.ClientRef; ref != nil && ref.Name != nil {
+				refs = append(refs, reference{
+					field:      part.field + ".clientRef",
+					targetKind: "A0Client",
+					target:     refKey(ref.Namespace, *ref.Name, g.Namespace),
+					newObject:  func() client.Object { return &v1.A0Client{} },
+				})
+			}
+			if ref := part.conf.Audience; ref != nil && ref.Name != nil {
+				refs = append(refs, reference{
+					field:      part.field + ".audience",
+					targetKind: "A0ResourceServer",
+					target:     refKey(ref.Namespace, *ref.Name, g.Namespace),
+					newObject:  func() client.Object { return &v1.A0ResourceServer{} },
+				})
+			}
+		}
+		if err := check("A0ClientGrant", g, refs); err != nil {
+			return nil, err
+		}
+	}
+
+	for idx := range inv.connections {
+		if err := check("A0Connection", &inv.connections[idx], tenantRefs(&inv.connections[idx])); err != nil {
+			return nil, err
+		}
+	}
+	for idx := range inv.resourceServers {
+		if err := check("A0ResourceServer", &inv.resourceServers[idx], tenantRefs(&inv.resourceServers[idx])); err != nil {
+			return nil, err
+		}
+	}
+	return dangling, nil
+}
+
+// Refs writes the dangling references of DanglingRefs as a table and returns how many there are.
+func (i *Inspector) Refs(ctx context.Context, out io.Writer) (int, error) {
+	dangling, err := i.DanglingRefs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(dangling) == 0 {
+		fmt.Fprintln(out, "No dangling references found.")
+		return 0, nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tFIELD\tMISSING")
+	for _, d := range dangling {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s %s\n", d.Kind, d.Key, d.Field, d.TargetKind, d.Target)
+	}
+	return len(dangling), w.Flush()
+}
+
+func tenantRefs(entity tenantEntity) []reference {
+	key, ok := tenantKey(entity, entity.GetNamespace())
+	if !ok {
+		return nil
+	}
+	return []reference{{
+		field:      "spec.tenantRef",
+		targetKind: "A0Tenant",
+		target:     key,
+		newObject:  func() client.Object { return &v1.A0Tenant{} },
+	}}
+}
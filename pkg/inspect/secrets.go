@@ -0,0 +1,68 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+)
+
+// Secrets writes the credentials Secret of each A0Client, whether it exists, whether the client owns it and thus
+// keeps it up to date, and which credential keys it holds.
+func (i *Inspector) Secrets(ctx context.Context, out io.Writer) error {
+	inv, err := i.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CLIENT\tSECRET\tSTATE\tKEYS")
+	for idx := range inv.clients {
+		c := &inv.clients[idx]
+		ref := c.Spec.SecretRef
+		if ref == nil {
+			fmt.Fprintf(w, "%s/%s\t<none>\t-\t-\n", c.Namespace, c.Name)
+			continue
+		}
+
+		key := refKey(&ref.Namespace, ref.Name, c.Namespace)
+		secret := &corev1.Secret{}
+		found, err := i.exists(ctx, key, secret)
+		if err != nil {
+			return err
+		}
+
+		state, keys := "Missing", "-"
+		if found {
+			state = "NotOwned"
+			if secretOwnedBy(secret, c) {
+				state = "Owned"
+			}
+			var present []string
+			for _, k := range []string{"clientId", "clientSecret"} {
+				if _, ok := secret.Data[k]; ok {
+					present = append(present, k)
+				}
+			}
+			if len(present) > 0 {
+				keys = strings.Join(present, ",")
+			}
+		}
+		fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", c.Namespace, c.Name, key, state, keys)
+	}
+	return w.Flush()
+}
+
+// secretOwnedBy reports whether the operator treats secret as owned by a0client, see A0ClientReconciler.
+func secretOwnedBy(secret *corev1.Secret, a0client *v1.A0Client) bool {
+	return metav1.IsControlledBy(secret, a0client) ||
+		secret.Annotations[controller.ClientOwnerAnnotation] == client.ObjectKeyFromObject(a0client).String()
+}
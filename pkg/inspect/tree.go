@@ -0,0 +1,147 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// node is a line of a tree and the lines nested below it
+type node struct {
+	label    string
+	children []*node
+}
+
+func (n *node) add(label string) *node {
+	child := &node{label: label}
+	n.children = append(n.children, child)
+	return child
+}
+
+func (n *node) write(w io.Writer, prefix string) {
+	for i, child := range n.children {
+		branch, indent := "├── ", "│   "
+		if i == len(n.children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(w, "%s%s%s\n", prefix, branch, child.label)
+		child.write(w, prefix+indent)
+	}
+}
+
+// Tree writes each tenant with its clients, the grants of each client and the resource server of each grant, followed
+// by the connections and resource servers of the tenant. Entities whose tenant is not listed are written last.
+func (i *Inspector) Tree(ctx context.Context, w io.Writer) error {
+	inv, err := i.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	roots := map[types.NamespacedName]*node{}
+	var order []*node
+	for idx := range inv.tenants {
+		t := &inv.tenants[idx]
+		domain := ""
+		if t.Spec.Auth != nil {
+			domain = deref(t.Spec.Auth.Domain)
+		}
+		root := &node{label: fmt.Sprintf("A0Tenant %s/%s (%s) %s", t.Namespace, t.Name, domain, readiness(t))}
+		roots[types.NamespacedName{Namespace: t.Namespace, Name: t.Name}] = root
+		order = append(order, root)
+	}
+	orphans := &node{label: "(unknown tenant)"}
+	rootOf := func(entity v1.TenantEntity, namespace string) *node {
+		if key, ok := tenantKey(entity, namespace); ok && roots[key] != nil {
+			return roots[key]
+		}
+		return orphans
+	}
+
+	clientNodes := map[types.NamespacedName]*node{}
+	clientIDs := map[string]*node{}
+	for idx := range inv.clients {
+		c := &inv.clients[idx]
+		n := rootOf(c, c.Namespace).add(fmt.Sprintf("A0Client %s/%s %s", c.Namespace, c.Name, status(c.GetAuth0ID(), c)))
+		clientNodes[types.NamespacedName{Namespace: c.Namespace, Name: c.Name}] = n
+		if id := c.GetAuth0ID(); id != "" {
+			clientIDs[id] = n
+		}
+	}
+
+	for idx := range inv.grants {
+		g := &inv.grants[idx]
+		label := fmt.Sprintf("A0ClientGrant %s/%s %s → %s", g.Namespace, g.Name, status(g.GetAuth0ID(), g), audienceLabel(inv, g))
+		parent := rootOf(g, g.Namespace)
+		if ref := grantConf(g).ClientRef; ref != nil {
+			if ref.Name != nil && clientNodes[refKey(ref.Namespace, *ref.Name, g.Namespace)] != nil {
+				parent = clientNodes[refKey(ref.Namespace, *ref.Name, g.Namespace)]
+			} else if ref.Id != nil && clientIDs[*ref.Id] != nil {
+				parent = clientIDs[*ref.Id]
+			}
+		}
+		parent.add(label)
+	}
+
+	for idx := range inv.connections {
+		c := &inv.connections[idx]
+		rootOf(c, c.Namespace).add(fmt.Sprintf("A0Connection %s/%s %s", c.Namespace, c.Name, status(c.GetAuth0ID(), c)))
+	}
+	for idx := range inv.resourceServers {
+		rs := &inv.resourceServers[idx]
+		rootOf(rs, rs.Namespace).add(fmt.Sprintf("A0ResourceServer %s/%s %s", rs.Namespace, rs.Name, status(rs.GetAuth0ID(), rs)))
+	}
+
+	if len(orphans.children) > 0 {
+		order = append(order, orphans)
+	}
+	for _, root := range order {
+		fmt.Fprintln(w, root.label)
+		root.write(w, "")
+	}
+	return nil
+}
+
+// grantConf returns the configuration used to find and create g.
+func grantConf(g *v1.A0ClientGrant) *v1.ClientGrantConf {
+	if g.Spec.Init != nil {
+		return g.Spec.Init
+	}
+	if g.Spec.Conf != nil {
+		return g.Spec.Conf
+	}
+	return &v1.ClientGrantConf{}
+}
+
+// audienceLabel names the resource server of a grant, and its identifier when it is known.
+func audienceLabel(inv *inventory, g *v1.A0ClientGrant) string {
+	ref := grantConf(g).Audience
+	if ref == nil {
+		return "(no audience)"
+	}
+	for idx := range inv.resourceServers {
+		rs := &inv.resourceServers[idx]
+		identifier := deref(rs.Status.Identifier)
+		if identifier == "" && rs.Spec.Conf != nil {
+			identifier = deref(rs.Spec.Conf.Identifier)
+		}
+		switch {
+		case ref.Name != nil && refKey(ref.Namespace, *ref.Name, g.Namespace) == types.NamespacedName{Namespace: rs.Namespace, Name: rs.Name},
+			ref.Id != nil && *ref.Id == rs.GetAuth0ID(),
+			ref.Identifier != nil && identifier != "" && strings.EqualFold(*ref.Identifier, identifier):
+			return fmt.Sprintf("A0ResourceServer %s/%s (%s)", rs.Namespace, rs.Name, identifier)
+		}
+	}
+	switch {
+	case ref.Identifier != nil:
+		return *ref.Identifier
+	case ref.Name != nil:
+		return fmt.Sprintf("A0ResourceServer %s (not found)", refKey(ref.Namespace, *ref.Name, g.Namespace))
+	default:
+		return fmt.Sprintf("resource server %s", deref(ref.Id))
+	}
+}
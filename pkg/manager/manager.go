@@ -0,0 +1,154 @@
+// Package manager assembles the controller-runtime manager of the Go operator, optionally scoped to a namespace and
+// to a partition of the A0* resources, so that several operator deployments can split the reconciliation of a
+// cluster between them.
+package manager
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/ratelimit"
+	"github.com/seatgeek/auth0-operator/pkg/controller"
+)
+
+// DefaultLeaderElectionID is the name of the Lease used for leader election, followed by the partition if there is one
+const DefaultLeaderElectionID = "auth0-operator"
+
+// Options configures the operator manager
+type Options struct {
+	// Namespace limits the operator to the resources in a single namespace. All namespaces are watched when empty.
+	// Tenants, credentials Secrets and referenced resources must be in the same namespace.
+	Namespace string
+
+	// Partition limits the operator to the resources whose v1.PartitionAnnotationKey annotation has this value.
+	// When empty, only resources without the annotation are reconciled.
+	Partition string
+
+	// LeaderElection enables leader election, so that only one replica of each partition reconciles at a time
+	LeaderElection bool
+
+	// LeaderElectionNamespace is the namespace of the leader election Lease. Defaults to the namespace the
+	// operator runs in.
+	LeaderElectionNamespace string
+
+	// LeaderElectionID overrides DefaultLeaderElectionID. The partition is appended to it.
+	LeaderElectionID string
+
+	// MetricsBindAddress is the address of the metrics endpoint, "0" disables it
+	MetricsBindAddress string
+
+	// HealthProbeBindAddress is the address of the health probe endpoint, "0" disables it
+	HealthProbeBindAddress string
+
+	// ReconcileInterval is how often entities are reconciled when nothing changes. Defaults to
+	// controller.DefaultReconcileInterval.
+	ReconcileInterval time.Duration
+
+	// HTTPClient is used for the Management API. Defaults to a client with a ratelimit.Transport.
+	HTTPClient *http.Client
+}
+
+// Scheme returns a scheme with the Kubernetes and A0* types
+func Scheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1.AddToScheme(scheme))
+	return scheme
+}
+
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// New returns a manager running the A0* reconcilers with opts
+func New(config *rest.Config, opts Options) (ctrl.Manager, error) {
+	mgr, err := ctrl.NewManager(config, managerOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Transport: ratelimit.NewTransport(nil)}
+	}
+	clients := management.NewCache(mgr.GetClient(), httpClient)
+
+	if err := (&controller.A0ClientReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Clients:   clients,
+		Interval:  opts.ReconcileInterval,
+		Partition: opts.Partition,
+	}).SetupWithManager(mgr); err != nil {
+		return nil, err
+	}
+	if err := (&controller.A0ClientGrantReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Clients:   clients,
+		Interval:  opts.ReconcileInterval,
+		Partition: opts.Partition,
+	}).SetupWithManager(mgr); err != nil {
+		return nil, err
+	}
+	if err := (&controller.A0ResourceServerReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Clients:   clients,
+		Interval:  opts.ReconcileInterval,
+		Partition: opts.Partition,
+	}).SetupWithManager(mgr); err != nil {
+		return nil, err
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		return nil, err
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		return nil, err
+	}
+	return mgr, nil
+}
+
+// managerOptions translates opts to the options of a controller-runtime manager.
+func managerOptions(opts Options) ctrl.Options {
+	options := ctrl.Options{
+		Scheme:                  Scheme(),
+		Metrics:                 metricsserver.Options{BindAddress: opts.MetricsBindAddress},
+		HealthProbeBindAddress:  opts.HealthProbeBindAddress,
+		LeaderElection:          opts.LeaderElection,
+		LeaderElectionID:        LeaderElectionID(opts.LeaderElectionID, opts.Partition),
+		LeaderElectionNamespace: opts.LeaderElectionNamespace,
+	}
+	if opts.Namespace != "" {
+		options.Cache = cache.Options{DefaultNamespaces: map[string]cache.Config{opts.Namespace: {}}}
+	}
+	return options
+}
+
+var invalidLeaseNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// LeaderElectionID returns the name of the leader election Lease of partition, so that the replicas of each
+// partition elect their own leader. id defaults to DefaultLeaderElectionID.
+func LeaderElectionID(id, partition string) string {
+	if id == "" {
+		id = DefaultLeaderElectionID
+	}
+	if partition == "" {
+		return id
+	}
+	suffix := strings.Trim(invalidLeaseNameChars.ReplaceAllString(strings.ToLower(partition), "-"), "-.")
+	return id + "-" + suffix
+}
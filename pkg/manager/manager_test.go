@@ -0,0 +1,33 @@
+package manager
+
+import (
+	"testing"
+)
+
+func TestLeaderElectionID(t *testing.T) {
+	for _, tc := range []struct {
+		id, partition, want string
+	}{
+		{"", "", DefaultLeaderElectionID},
+		{"", "east", "auth0-operator-east"},
+		{"custom", "East_Coast/1", "custom-east-coast-1"},
+	} {
+		if got := LeaderElectionID(tc.id, tc.partition); got != tc.want {
+			t.Errorf("LeaderElectionID(%q, %q) = %q, want %q", tc.id, tc.partition, got, tc.want)
+		}
+	}
+}
+
+func TestManagerOptions(t *testing.T) {
+	options := managerOptions(Options{Namespace: "auth0", Partition: "east", LeaderElection: true})
+	if _, ok := options.Cache.DefaultNamespaces["auth0"]; !ok || len(options.Cache.DefaultNamespaces) != 1 {
+		t.Fatalf("expected the cache to be scoped to the namespace, got %v", options.Cache.DefaultNamespaces)
+	}
+	if !options.LeaderElection || options.LeaderElectionID != "auth0-operator-east" {
+		t.Fatalf("expected leader election per partition, got %v %q", options.LeaderElection, options.LeaderElectionID)
+	}
+
+	if options := managerOptions(Options{}); options.Cache.DefaultNamespaces != nil {
+		t.Fatalf("expected all namespaces to be watched, got %v", options.Cache.DefaultNamespaces)
+	}
+}
@@ -0,0 +1,76 @@
+// Package manifest writes A0* resources as ready-to-apply YAML manifests.
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Marshal returns obj as YAML without its status and the server-populated metadata, such as the
+// creationTimestamp of an object that was never stored.
+func Marshal(obj client.Object) ([]byte, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(u, "status")
+	// policy is not omitempty so that an empty policy is kept, leave an unset one out instead of writing null
+	if spec, ok := u["spec"].(map[string]interface{}); ok && spec["policy"] == nil {
+		delete(spec, "policy")
+	}
+	if meta, ok := u["metadata"].(map[string]interface{}); ok {
+		for _, f := range []string{"creationTimestamp", "resourceVersion", "uid", "generation", "managedFields"} {
+			delete(meta, f)
+		}
+	}
+	return yaml.Marshal(u)
+}
+
+// Write writes objs to w as a multi-document YAML stream.
+func Write(w io.Writer, objs []client.Object) error {
+	for i, obj := range objs {
+		data, err := Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", FileName(obj), err)
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteFiles writes each of objs to its own file in dir, named by FileName, creating dir if needed.
+func WriteFiles(dir string, objs []client.Object) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		var buf bytes.Buffer
+		if err := Write(&buf, []client.Object{obj}); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, FileName(obj)), buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FileName returns the file name of obj in WriteFiles, e.g. "a0client-my-app.yaml".
+func FileName(obj client.Object) string {
+	return strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind) + "-" + obj.GetName() + ".yaml"
+}
@@ -0,0 +1,97 @@
+package manifest_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+func TestWriteFiles(t *testing.T) {
+	name := "My App"
+	obj := &v1.A0Client{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1.GroupVersion.String(), Kind: "A0Client"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-app"},
+		Spec:       v1.A0ClientSpec{TenantRef: &v1.V1TenantReference{Name: "prod"}, Conf: &v1.ClientConf{Name: &name}},
+	}
+
+	dir := t.TempDir()
+	if err := manifest.WriteFiles(dir, []client.Object{obj}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "a0client-my-app.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata:
+  name: my-app
+  namespace: default
+spec:
+  conf:
+    name: My App
+  tenantRef:
+    name: prod
+`
+	if string(data) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", data, want)
+	}
+	if strings.Contains(string(data), "---") {
+		t.Error("expected a single document")
+	}
+}
+
+func TestReadFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"clients.yaml": `apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata:
+  name: web
+  namespace: auth0
+spec:
+  tenantRef:
+    name: prod
+  conf:
+    name: Web
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: web-credentials
+`,
+		"kustomization.yaml": "resources:\n- clients.yaml\n",
+		"README.md":          "not a manifest",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objs, err := manifest.ReadFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("expected the client and the secret, got %d objects", len(objs))
+	}
+	c, ok := objs[0].(*v1.A0Client)
+	if !ok || c.Name != "web" || *c.Spec.Conf.Name != "Web" || c.Spec.TenantRef.Name != "prod" {
+		t.Errorf("unexpected first object %#v", objs[0])
+	}
+	if kind := manifest.FileName(objs[0]); kind != "a0client-web.yaml" {
+		t.Errorf("expected the kind to be kept, got file name %s", kind)
+	}
+
+	if _, err := manifest.Read(strings.NewReader("apiVersion: example.com/v1\nkind: Unknown\n")); err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+}
@@ -0,0 +1,42 @@
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Namer turns Auth0 names into Kubernetes names that are unique per kind
+type Namer struct {
+	used map[string]bool
+}
+
+// NewNamer returns a Namer with no names taken.
+func NewNamer() *Namer {
+	return &Namer{used: map[string]bool{}}
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Next returns a DNS-1123 name derived from name, or fallback when name has no usable characters, with a numeric
+// suffix when the name is already taken by another resource of kind.
+func (n *Namer) Next(kind string, name interface{}, fallback string) string {
+	s, _ := name.(string)
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	if base == "" {
+		base = strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(fallback), "-"), "-")
+	}
+	if base == "" {
+		base = kind
+	}
+	if len(base) > 58 {
+		base = strings.TrimRight(base[:58], "-")
+	}
+
+	candidate := base
+	for i := 2; n.used[kind+"/"+candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+	n.used[kind+"/"+candidate] = true
+	return candidate
+}
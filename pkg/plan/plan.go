@@ -0,0 +1,722 @@
+// Package plan computes the changes that applying a set of A0* manifests would make to an Auth0 tenant, without
+// calling the Management API or a cluster. Each resource is found, created, updated or skipped with the same rules
+// the operator reconciles it with: Spec.Find, the name or identifier of Init or Conf, Spec.Policy and Spec.Mode.
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// Action is what applying the manifests does to an Auth0 entity
+type Action string
+
+const (
+	// Create is an entity that is not found and that the operator creates
+	Create Action = "Create"
+
+	// Update is an entity that is found and whose configuration differs from it
+	Update Action = "Update"
+
+	// Delete is an entity whose resource was removed from the manifests and whose policy allows deletion
+	Delete Action = "Delete"
+
+	// NoChange is an entity that is found and matches its configuration
+	NoChange Action = "NoChange"
+
+	// Skip is an entity the operator leaves alone, because its policy denies the change, its resource is not in Apply
+	// mode or it is invalid
+	Skip Action = "Skip"
+)
+
+// Options control which resources are planned
+type Options struct {
+	// Tenant is the name of the A0Tenant the snapshot was taken from. Resources of other tenants are skipped. It
+	// defaults to the only A0Tenant of the manifests; without one, every resource is planned against the snapshot.
+	Tenant string
+
+	// Previous are the manifests that are currently applied, e.g. those of the target branch of a pull request.
+	// Resources of Previous that the manifests no longer have are planned for deletion.
+	Previous []client.Object
+}
+
+// Plan lists the change to each entity in the order the operator depends on
+type Plan struct {
+	Changes []Change `json:"changes"`
+	Summary Summary  `json:"summary"`
+}
+
+// Change is what happens to the Auth0 entity of a resource
+type Change struct {
+	// Kind is the kind of the resource, e.g. A0Client
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource, empty when the manifest has none
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Action is what happens to the entity
+	Action Action `json:"action"`
+
+	// ID is the Auth0 ID of the entity that was found, empty when it was not
+	ID string `json:"id,omitempty"`
+
+	// FoundBy says how the entity was found, e.g. "find.client_id" or "name"
+	FoundBy string `json:"foundBy,omitempty"`
+
+	// Reason explains a Skip, or how a Create or Delete comes about
+	Reason string `json:"reason,omitempty"`
+
+	// Fields are the field-level changes, the whole configuration for a Create. A Skip lists the changes the
+	// policy denies.
+	Fields []drift.Difference `json:"fields,omitempty"`
+}
+
+// Summary counts the changes by action
+type Summary struct {
+	Create   int `json:"create"`
+	Update   int `json:"update"`
+	Delete   int `json:"delete"`
+	NoChange int `json:"noChange"`
+	Skip     int `json:"skip"`
+}
+
+// Empty reports whether p changes nothing.
+func (p *Plan) Empty() bool {
+	return p.Summary.Create == 0 && p.Summary.Update == 0 && p.Summary.Delete == 0
+}
+
+// kindOrder is the order in which the operator can reconcile the kinds, since later kinds reference earlier ones
+var kindOrder = map[string]int{"A0Tenant": 0, "A0Connection": 1, "A0ResourceServer": 2, "A0Client": 3, "A0ClientGrant": 4}
+
+// Compute returns the changes that applying objs would make to the tenant of snap. Objects that are not A0*
+// resources are ignored.
+func Compute(objs []client.Object, snap *Snapshot, opts Options) (*Plan, error) {
+	tenant := opts.Tenant
+	if tenant == "" {
+		var tenants []string
+		for _, obj := range objs {
+			if _, ok := obj.(*v1.A0Tenant); ok {
+				tenants = append(tenants, obj.GetName())
+			}
+		}
+		if len(tenants) == 1 {
+			tenant = tenants[0]
+		}
+	}
+
+	p := &Plan{}
+	current := newPlanner(snap, tenant)
+	present := map[string]bool{}
+	for _, obj := range ordered(objs) {
+		present[resourceKey(obj)] = true
+		e, reason, err := current.entity(obj)
+		if err != nil {
+			return nil, err
+		}
+		change := newChange(obj)
+		switch {
+		case reason != "":
+			change.Action, change.Reason = Skip, reason
+		default:
+			if change, err = e.decide(change); err != nil {
+				return nil, err
+			}
+		}
+		p.add(change)
+	}
+
+	// removed resources are resolved in dependency order, but listed in reverse since dependents go first
+	previous := newPlanner(snap, tenant)
+	var removed []Change
+	for _, obj := range ordered(opts.Previous) {
+		e, reason, err := previous.entity(obj)
+		if err != nil {
+			return nil, err
+		}
+		if present[resourceKey(obj)] {
+			continue
+		}
+		change := newChange(obj)
+		switch {
+		case reason != "":
+			change.Action, change.Reason = Skip, "removed from the manifests, but "+reason
+		default:
+			change = e.deletion(change)
+		}
+		removed = append(removed, change)
+	}
+	sort.SliceStable(removed, func(i, j int) bool { return kindOrder[removed[i].Kind] > kindOrder[removed[j].Kind] })
+	for _, change := range removed {
+		p.add(change)
+	}
+	return p, nil
+}
+
+func (p *Plan) add(c Change) {
+	p.Changes = append(p.Changes, c)
+	switch c.Action {
+	case Create:
+		p.Summary.Create++
+	case Update:
+		p.Summary.Update++
+	case Delete:
+		p.Summary.Delete++
+	case NoChange:
+		p.Summary.NoChange++
+	case Skip:
+		p.Summary.Skip++
+	}
+}
+
+// entity is a resource resolved against the snapshot: the live entity it was found as, the configuration it would
+// be created from and the configuration it is updated to, with references replaced by Auth0 IDs
+type entity struct {
+	kind   string
+	policy []v1.V1EntityPolicyType
+	mode   v1.V1ReconcileMode
+
+	// live is the entity found in the snapshot, nil when it was not found
+	live    management.Object
+	id      string
+	foundBy string
+
+	// body is the configuration the entity is created from, and created the entity that results from it
+	body    interface{}
+	created management.Object
+
+	// conf is the configuration compared with the live entity, without the fields of ignored
+	conf    interface{}
+	ignored []string
+
+	// invalid says why the entity cannot be created
+	invalid string
+
+	// deletable is false for kinds the operator never deletes
+	deletable bool
+}
+
+func (e *entity) hasPolicy(policy v1.V1EntityPolicyType) bool {
+	for _, p := range e.policy {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// decide returns change with the action the operator takes for e.
+func (e *entity) decide(change Change) (Change, error) {
+	if e.mode != v1.ReconcileModeApply {
+		change.ID, change.FoundBy = e.id, e.foundBy
+		change.Action, change.Reason = Skip, fmt.Sprintf("mode is %s", e.mode)
+		return change, nil
+	}
+	if e.live == nil {
+		if !e.hasPolicy(v1.PolicyTypeCreate) {
+			change.Action, change.Reason = Skip, "not found, and the policy does not include Create"
+			return change, nil
+		}
+		if e.invalid != "" {
+			change.Action, change.Reason = Skip, e.invalid
+			return change, nil
+		}
+
+		fields, err := drift.Compare(e.body, management.Object{})
+		if err != nil {
+			return change, err
+		}
+		change.Action, change.Fields = Create, fields
+		if e.hasPolicy(v1.PolicyTypeUpdate) {
+			// the configuration is applied right after the entity is created, e.g. over an Init that differs from it
+			updates, err := drift.Compare(e.conf, e.created)
+			if err != nil {
+				return change, err
+			}
+			change.Fields = append(change.Fields, updates...)
+		}
+		return change, nil
+	}
+
+	change.ID, change.FoundBy = e.id, e.foundBy
+	fields, err := drift.CompareIgnoring(e.conf, e.live, e.ignored)
+	if err != nil {
+		return change, err
+	}
+	change.Fields = fields
+	switch {
+	case len(fields) == 0:
+		change.Action = NoChange
+	case e.hasPolicy(v1.PolicyTypeUpdate):
+		change.Action = Update
+	default:
+		change.Action, change.Reason = Skip, "differs from the configuration, but the policy does not include Update"
+	}
+	return change, nil
+}
+
+// deletion returns change for e, whose resource was removed from the manifests.
+func (e *entity) deletion(change Change) Change {
+	change.ID, change.FoundBy = e.id, e.foundBy
+	switch {
+	case e.live == nil:
+		change.Action, change.Reason = NoChange, "removed from the manifests and not found"
+	case !e.deletable:
+		change.Action, change.Reason = Skip, fmt.Sprintf("removed from the manifests, but a %s is never deleted", strings.TrimPrefix(e.kind, "A0"))
+	case e.mode != v1.ReconcileModeApply:
+		change.Action, change.Reason = Skip, fmt.Sprintf("removed from the manifests, but mode is %s", e.mode)
+	case !e.hasPolicy(v1.PolicyTypeDelete):
+		change.Action, change.Reason = Skip, "removed from the manifests, but the policy does not include Delete"
+	default:
+		change.Action, change.Reason = Delete, "removed from the manifests"
+	}
+	return change
+}
+
+// planner resolves resources against a snapshot. Resources are resolved in the order of kindOrder, so that the
+// Auth0 IDs of the resources others reference are known.
+type planner struct {
+	snap   *Snapshot
+	tenant string
+
+	// ids holds the Auth0 ID of each resolved resource by resourceKey, a placeholder for one that is created
+	ids map[string]string
+
+	// identifiers holds the identifier of each resolved A0ResourceServer by resourceKey
+	identifiers map[string]string
+}
+
+func newPlanner(snap *Snapshot, tenant string) *planner {
+	return &planner{snap: snap, tenant: tenant, ids: map[string]string{}, identifiers: map[string]string{}}
+}
+
+// entity resolves obj, or returns why it is skipped. The returned entity is nil for objects that are not A0*
+// resources.
+func (p *planner) entity(obj client.Object) (*entity, string, error) {
+	var ignored []string
+	if entity, ok := obj.(v1.TenantEntity); ok {
+		ref := entity.GetTenantRef()
+		if ref == nil || ref.Name == "" {
+			return nil, "the resource has no tenantRef", nil
+		}
+		if p.tenant != "" && ref.Name != p.tenant {
+			return nil, fmt.Sprintf("the resource belongs to A0Tenant %s, not %s", ref.Name, p.tenant), nil
+		}
+		ignored = entity.GetIgnoreFields()
+	}
+
+	var (
+		e      *entity
+		reason string
+		err    error
+	)
+	switch obj := obj.(type) {
+	case *v1.A0Tenant:
+		e, reason, err = p.tenantEntity(obj)
+	case *v1.A0Connection:
+		e, reason, err = p.connection(obj)
+	case *v1.A0ResourceServer:
+		e, reason, err = p.resourceServer(obj)
+	case *v1.A0Client:
+		e, reason, err = p.client(obj)
+	case *v1.A0ClientGrant:
+		e, reason, err = p.clientGrant(obj)
+	}
+	if e != nil {
+		e.ignored = ignored
+		e.mode = v1.ReconcileModeApply
+		if r, ok := obj.(planned); ok {
+			e.mode = r.GetMode()
+		}
+	}
+	return e, reason, err
+}
+
+func (p *planner) tenantEntity(t *v1.A0Tenant) (*entity, string, error) {
+	if t.Spec.Conf == nil {
+		return nil, "the resource is missing configuration", nil
+	}
+	if p.snap.Tenant == nil {
+		return nil, "the snapshot has no tenant settings", nil
+	}
+	policy := t.Spec.Policy
+	if policy == nil {
+		policy = v1.DefaultPolicy
+	}
+	return &entity{kind: "A0Tenant", policy: policy, live: p.snap.Tenant, foundBy: "tenant settings", conf: t.Spec.Conf}, "", nil
+}
+
+func (p *planner) connection(c *v1.A0Connection) (*entity, string, error) {
+	if c.Spec.Conf == nil {
+		return nil, "the resource is missing configuration", nil
+	}
+	body := c.Spec.Init
+	if body == nil {
+		body = c.Spec.Conf
+	}
+	e := &entity{kind: "A0Connection", policy: c.GetPolicy(), body: body, conf: c.Spec.Conf, deletable: true}
+
+	switch {
+	case lookup(p.snap.Connections, "id", c.GetAuth0ID()) != nil:
+		e.id, e.foundBy = c.GetAuth0ID(), "status.id"
+	case c.Spec.Find != nil && c.Spec.Find.ConnectionId != nil && *c.Spec.Find.ConnectionId != "":
+		if lookup(p.snap.Connections, "id", *c.Spec.Find.ConnectionId) != nil {
+			e.id, e.foundBy = *c.Spec.Find.ConnectionId, "find.id"
+		}
+	case body.Name != nil:
+		if found := lookupFold(p.snap.Connections, "name", *body.Name); found != nil {
+			e.id, _ = found["id"].(string)
+			e.foundBy = "name"
+		}
+	}
+	if e.id != "" {
+		e.live = lookup(p.snap.Connections, "id", e.id)
+	}
+	if body.Strategy == nil {
+		e.invalid = "the configuration is missing a strategy"
+	}
+
+	created, err := drift.ToObject(body)
+	if err != nil {
+		return nil, "", err
+	}
+	e.created = created
+	p.ids[resourceKey(c)] = p.plannedID(c, e.id)
+	return e, "", nil
+}
+
+func (p *planner) resourceServer(rs *v1.A0ResourceServer) (*entity, string, error) {
+	if rs.Spec.Conf == nil {
+		return nil, "the resource is missing configuration", nil
+	}
+	body := rs.Spec.Init
+	if body == nil {
+		body = rs.Spec.Conf
+	}
+	e := &entity{kind: "A0ResourceServer", policy: rs.GetPolicy(), body: body, conf: rs.Spec.Conf, deletable: true}
+
+	switch {
+	case lookup(p.snap.ResourceServers, "id", rs.GetAuth0ID()) != nil:
+		e.id, e.foundBy = rs.GetAuth0ID(), "status.id"
+	case body.Identifier != nil:
+		if found := lookupFold(p.snap.ResourceServers, "identifier", *body.Identifier); found != nil {
+			e.id, _ = found["id"].(string)
+			e.foundBy = "identifier"
+		}
+	}
+	if e.id != "" {
+		e.live = lookup(p.snap.ResourceServers, "id", e.id)
+	}
+	if body.Identifier == nil {
+		e.invalid = "the configuration is missing an identifier"
+	}
+
+	// the identifier cannot be updated, so the desired configuration leaves it out like the reconciler does
+	conf := rs.Spec.Conf.DeepCopy()
+	conf.Identifier = nil
+	e.conf = conf
+
+	created, err := drift.ToObject(body)
+	if err != nil {
+		return nil, "", err
+	}
+	e.created = created
+	p.ids[resourceKey(rs)] = p.plannedID(rs, e.id)
+	switch {
+	case e.live != nil:
+		p.identifiers[resourceKey(rs)], _ = e.live["identifier"].(string)
+	case body.Identifier != nil:
+		p.identifiers[resourceKey(rs)] = *body.Identifier
+	}
+	return e, "", nil
+}
+
+func (p *planner) client(c *v1.A0Client) (*entity, string, error) {
+	if c.Spec.Conf == nil {
+		return nil, "the resource is missing configuration", nil
+	}
+	init := c.Spec.Init
+	if init == nil {
+		init = c.Spec.Conf
+	}
+	e := &entity{kind: "A0Client", policy: c.GetPolicy(), deletable: true}
+
+	switch find := c.Spec.Find; {
+	case lookup(p.snap.Clients, "client_id", c.GetAuth0ID()) != nil:
+		e.id, e.foundBy = c.GetAuth0ID(), "status.id"
+	case find != nil && find.ClientId != nil && *find.ClientId != "":
+		if lookup(p.snap.Clients, "client_id", *find.ClientId) != nil {
+			e.id, e.foundBy = *find.ClientId, "find.client_id"
+		}
+	case find != nil:
+		for _, item := range p.snap.Clients {
+			if management.HasMatchingCallbackUrls(item, find) {
+				e.id, _ = item["client_id"].(string)
+				e.foundBy = "find.callback_urls"
+				break
+			}
+		}
+	case init.Name != nil:
+		if found := lookupFold(p.snap.Clients, "name", *init.Name); found != nil {
+			e.id, _ = found["client_id"].(string)
+			e.foundBy = "name"
+		}
+	}
+	if e.id != "" {
+		e.live = copyObject(lookup(p.snap.Clients, "client_id", e.id))
+	}
+	if init.ApplicationType == nil {
+		e.invalid = "the configuration is missing a value for application type"
+	}
+
+	// enabled connections are not part of the body, they are enabled once the client exists
+	body := init.DeepCopy()
+	body.EnabledConnections = nil
+	e.body = body
+	created, err := drift.ToObject(body)
+	if err != nil {
+		return nil, "", err
+	}
+	delete(created, "client_id")
+	e.created = created
+
+	conf := c.Spec.Conf.DeepCopy()
+	if conf.EnabledConnections != nil && e.hasPolicy(v1.PolicyTypeUpdate) {
+		refs := make([]v1.V1ConnectionReference, 0, len(conf.EnabledConnections))
+		for _, ref := range conf.EnabledConnections {
+			id, reason := p.connectionID(ref, c.Namespace)
+			if reason != "" {
+				return nil, reason, nil
+			}
+			refs = append(refs, v1.V1ConnectionReference{Id: &id})
+		}
+		conf.EnabledConnections = refs
+		e.created["enabled_connections"] = []interface{}{}
+	} else {
+		conf.EnabledConnections = nil
+	}
+	e.conf = conf
+
+	p.ids[resourceKey(c)] = p.plannedID(c, e.id)
+	return e, "", nil
+}
+
+func (p *planner) clientGrant(g *v1.A0ClientGrant) (*entity, string, error) {
+	if g.Spec.Conf == nil {
+		return nil, "the resource is missing configuration", nil
+	}
+	init := g.Spec.Init
+	if init == nil {
+		init = g.Spec.Conf
+	}
+	e := &entity{kind: "A0ClientGrant", policy: g.GetPolicy(), deletable: true}
+
+	// a grant is identified by its client and audience, so both have to be resolved before it can be found
+	clientID, reason := p.clientID(init.ClientRef, g.Namespace)
+	if reason != "" {
+		return nil, reason, nil
+	}
+	audience, reason := p.audience(init.Audience, g.Namespace)
+	if reason != "" {
+		return nil, reason, nil
+	}
+
+	if found := lookup(p.snap.ClientGrants, "id", g.GetAuth0ID()); found != nil {
+		e.id, e.foundBy, e.live = g.GetAuth0ID(), "status.id", found
+	} else {
+		for _, item := range p.snap.ClientGrants {
+			itemAudience, _ := item["audience"].(string)
+			if item["client_id"] == clientID && strings.EqualFold(itemAudience, audience) {
+				e.id, _ = item["id"].(string)
+				e.foundBy, e.live = "client and audience", item
+				break
+			}
+		}
+	}
+
+	e.body = &v1.ClientGrantConf{
+		ClientRef: &v1.V1ClientReference{Id: &clientID},
+		Audience:  &v1.V1ResourceServerReference{Identifier: &audience},
+		Scope:     init.Scope,
+	}
+	e.created = management.Object{"client_id": clientID, "audience": audience, "scope": toList(init.Scope)}
+	// only the scope of a grant is updated, and a missing scope removes every scope
+	scope := g.Spec.Conf.Scope
+	if scope == nil {
+		scope = []string{}
+	}
+	e.conf = &v1.ClientGrantConf{Scope: scope}
+	p.ids[resourceKey(g)] = p.plannedID(g, e.id)
+	return e, "", nil
+}
+
+// plannedID returns id, or a placeholder for the entity of obj when it is created.
+func (p *planner) plannedID(obj client.Object, id string) string {
+	if id != "" {
+		return id
+	}
+	return fmt.Sprintf("(new %s %s)", kindOf(obj), displayKey(obj.GetNamespace(), obj.GetName()))
+}
+
+// clientID resolves a client reference the way the reconciler does, or returns why it cannot be resolved.
+func (p *planner) clientID(ref *v1.V1ClientReference, namespace string) (string, string) {
+	if ref == nil {
+		return "", "the configuration is missing clientRef"
+	}
+	if ref.Id != nil && *ref.Id != "" {
+		return *ref.Id, ""
+	}
+	if ref.Name == nil || *ref.Name == "" {
+		return "", "the client reference has no name"
+	}
+	key := refKey("A0Client", ref.Namespace, *ref.Name, namespace)
+	id, ok := p.ids[key]
+	if !ok {
+		return "", fmt.Sprintf("A0Client %s is not among the manifests", strings.TrimPrefix(key, "A0Client/"))
+	}
+	return id, ""
+}
+
+// audience resolves a resource server reference to an identifier the way the reconciler does, or returns why it
+// cannot be resolved.
+func (p *planner) audience(ref *v1.V1ResourceServerReference, namespace string) (string, string) {
+	if ref == nil {
+		return "", "the configuration is missing an audience"
+	}
+	if ref.Identifier != nil && *ref.Identifier != "" {
+		return *ref.Identifier, ""
+	}
+	if ref.Id != nil && *ref.Id != "" {
+		rs := lookup(p.snap.ResourceServers, "id", *ref.Id)
+		if rs == nil {
+			return "", fmt.Sprintf("resource server %s is not in the tenant", *ref.Id)
+		}
+		identifier, _ := rs["identifier"].(string)
+		return identifier, ""
+	}
+	if ref.Name == nil || *ref.Name == "" {
+		return "", "the resource server reference has no identifier, name or id"
+	}
+	key := refKey("A0ResourceServer", ref.Namespace, *ref.Name, namespace)
+	identifier, ok := p.identifiers[key]
+	if !ok {
+		return "", fmt.Sprintf("A0ResourceServer %s is not among the manifests", strings.TrimPrefix(key, "A0ResourceServer/"))
+	}
+	return identifier, ""
+}
+
+// connectionID resolves a connection reference the way the reconciler does, or returns why it cannot be resolved.
+func (p *planner) connectionID(ref v1.V1ConnectionReference, namespace string) (string, string) {
+	if ref.Id != nil && *ref.Id != "" {
+		return *ref.Id, ""
+	}
+	if ref.Name == nil || *ref.Name == "" {
+		return "", "a connection reference has no name or id"
+	}
+	key := refKey("A0Connection", ref.Namespace, *ref.Name, namespace)
+	id, ok := p.ids[key]
+	if !ok {
+		return "", fmt.Sprintf("A0Connection %s is not among the manifests", strings.TrimPrefix(key, "A0Connection/"))
+	}
+	return id, ""
+}
+
+// ordered returns the A0* resources of objs sorted by kindOrder, keeping the order of the manifests within a kind.
+func ordered(objs []client.Object) []client.Object {
+	var out []client.Object
+	for _, obj := range objs {
+		if _, ok := kindOrder[kindOf(obj)]; ok {
+			out = append(out, obj)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return kindOrder[kindOf(out[i])] < kindOrder[kindOf(out[j])] })
+	return out
+}
+
+func kindOf(obj client.Object) string {
+	switch obj.(type) {
+	case *v1.A0Tenant:
+		return "A0Tenant"
+	case *v1.A0Connection:
+		return "A0Connection"
+	case *v1.A0ResourceServer:
+		return "A0ResourceServer"
+	case *v1.A0Client:
+		return "A0Client"
+	case *v1.A0ClientGrant:
+		return "A0ClientGrant"
+	}
+	return ""
+}
+
+func newChange(obj client.Object) Change {
+	return Change{Kind: kindOf(obj), Namespace: obj.GetNamespace(), Name: obj.GetName()}
+}
+
+func resourceKey(obj client.Object) string {
+	return kindOf(obj) + "/" + types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String()
+}
+
+// refKey returns the resourceKey of a reference by name, which defaults to the namespace of the referencing resource.
+func refKey(kind string, namespace *string, name, defaultNamespace string) string {
+	if namespace != nil && *namespace != "" {
+		defaultNamespace = *namespace
+	}
+	return kind + "/" + types.NamespacedName{Namespace: defaultNamespace, Name: name}.String()
+}
+
+func displayKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// lookup returns the object of list whose key field is value, or nil.
+func lookup(list []management.Object, key, value string) management.Object {
+	if value == "" {
+		return nil
+	}
+	for _, obj := range list {
+		if v, _ := obj[key].(string); v == value {
+			return obj
+		}
+	}
+	return nil
+}
+
+// lookupFold returns the first object of list whose key field is value compared case-insensitively, or nil.
+func lookupFold(list []management.Object, key, value string) management.Object {
+	for _, obj := range list {
+		if v, _ := obj[key].(string); strings.EqualFold(v, value) {
+			return obj
+		}
+	}
+	return nil
+}
+
+func copyObject(obj management.Object) management.Object {
+	out := make(management.Object, len(obj))
+	for k, v := range obj {
+		out[k] = v
+	}
+	return out
+}
+
+func toList(values []string) []interface{} {
+	list := make([]interface{}, len(values))
+	for i, v := range values {
+		list[i] = v
+	}
+	return list
+}
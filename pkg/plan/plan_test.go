@@ -0,0 +1,317 @@
+package plan_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/fake"
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+	"github.com/seatgeek/auth0-operator/pkg/plan"
+)
+
+const manifests = `apiVersion: kubernetes.auth0.com/v1
+kind: A0Tenant
+metadata: {name: example, namespace: auth0}
+spec:
+  name: example
+  conf: {friendly_name: Example}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata: {name: web, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  find: {client_id: cl_web}
+  conf:
+    name: Web
+    app_type: regular_web
+    callbacks: [https://example.com/cb, https://www.example.com/cb]
+    enabled_connections: [{name: database}, {name: google}]
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata: {name: spa, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  init: {name: SPA, app_type: spa, description: initial}
+  conf: {name: SPA, app_type: spa, description: final}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata: {name: worker, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  policy: [Update]
+  conf: {name: Worker, app_type: non_interactive}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0Connection
+metadata: {name: database, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  conf: {name: Username-Password-Authentication, strategy: auth0}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0Connection
+metadata: {name: google, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  conf: {name: google-oauth2, strategy: google-oauth2}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0ResourceServer
+metadata: {name: things, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  conf: {name: Things, identifier: https://API.example.com}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0ClientGrant
+metadata: {name: web-things, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  conf:
+    clientRef: {name: web}
+    audience: {name: things}
+    scope: [read:things, write:things]
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0ClientGrant
+metadata: {name: other, namespace: auth0}
+spec:
+  tenantRef: {name: other}
+  conf:
+    clientRef: {name: web}
+    audience: {identifier: https://api.example.com}
+`
+
+const previous = `apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata: {name: legacy, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  policy: [Create, Update, Delete]
+  conf: {name: Legacy}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0ResourceServer
+metadata: {name: things-v0, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  conf: {identifier: https://v0.example.com}
+`
+
+const snapshot = `{
+  "tenant": {"friendly_name": "Example Dev", "enabled_locales": ["en"]},
+  "clients": [
+    {"client_id": "cl_web", "name": "Web", "app_type": "regular_web", "callbacks": ["https://example.com/cb"]},
+    {"client_id": "cl_legacy", "name": "legacy", "app_type": "spa"}
+  ],
+  "connections": [
+    {"id": "con_db", "name": "Username-Password-Authentication", "strategy": "auth0", "enabled_clients": ["cl_web", "cl_legacy"]}
+  ],
+  "resourceServers": [
+    {"id": "rs_things", "name": "Things", "identifier": "https://api.example.com"},
+    {"id": "rs_v0", "name": "Things v0", "identifier": "https://v0.example.com"}
+  ],
+  "clientGrants": [
+    {"id": "cgr_web", "client_id": "cl_web", "audience": "https://api.example.com", "scope": ["read:things"]}
+  ]
+}`
+
+func TestCompute(t *testing.T) {
+	objs, err := manifest.Read(strings.NewReader(manifests))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, err := manifest.Read(strings.NewReader(previous))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := os.WriteFile(path, []byte(snapshot), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := plan.ReadSnapshot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := plan.Compute(objs, snap, plan.Options{Previous: append(prev, objs[1])})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := p.WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `~ A0Tenant auth0/example: Update (found by tenant settings)
+    ~ friendly_name: "Example Dev" -> "Example"
+= A0Connection auth0/database: NoChange (found con_db by name)
++ A0Connection auth0/google: Create
+    + name: "google-oauth2"
+    + strategy: "google-oauth2"
+= A0ResourceServer auth0/things: NoChange (found rs_things by identifier)
+~ A0Client auth0/web: Update (found cl_web by find.client_id)
+    + callbacks[1]: "https://www.example.com/cb"
+    + enabled_connections[1]: "(new A0Connection auth0/google)"
++ A0Client auth0/spa: Create
+    + app_type: "spa"
+    + description: "initial"
+    + name: "SPA"
+    ~ description: "initial" -> "final"
+! A0Client auth0/worker: Skip, not found, and the policy does not include Create
+~ A0ClientGrant auth0/web-things: Update (found cgr_web by client and audience)
+    + scope[1]: "write:things"
+! A0ClientGrant auth0/other: Skip, the resource belongs to A0Tenant other, not example
+- A0Client auth0/legacy: Delete (found cl_legacy by name), removed from the manifests
+! A0ResourceServer auth0/things-v0: Skip (found rs_v0 by identifier), removed from the manifests, but the policy does not include Delete
+
+Plan: 2 to create, 3 to update, 1 to delete, 2 unchanged, 3 skipped.
+`
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err := p.WriteJSON(&out); err != nil {
+		t.Fatal(err)
+	}
+	var decoded plan.Plan
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Summary != p.Summary || decoded.Changes[4].Fields[0].Path != "callbacks[1]" {
+		t.Errorf("unexpected JSON:\n%s", out.String())
+	}
+}
+
+func TestComputeSkipsPlanAndObserveModes(t *testing.T) {
+	objs, err := manifest.Read(strings.NewReader(`apiVersion: kubernetes.auth0.com/v1
+kind: A0ResourceServer
+metadata: {name: things, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  mode: Observe
+  conf: {name: Renamed, identifier: https://api.example.com}
+---
+apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata: {name: spa, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  mode: Plan
+  conf: {name: SPA, app_type: spa}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, err := manifest.Read(strings.NewReader(`apiVersion: kubernetes.auth0.com/v1
+kind: A0Client
+metadata: {name: legacy, namespace: auth0}
+spec:
+  tenantRef: {name: example}
+  policy: [Create, Update, Delete]
+  mode: Observe
+  conf: {name: Legacy}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap := &plan.Snapshot{
+		Clients:         []management.Object{{"client_id": "cl_legacy", "name": "Legacy"}},
+		ResourceServers: []management.Object{{"id": "rs_things", "name": "Things", "identifier": "https://api.example.com"}},
+	}
+
+	p, err := plan.Compute(objs, snap, plan.Options{Previous: prev})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := p.WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `! A0ResourceServer auth0/things: Skip (found rs_things by identifier), mode is Observe
+! A0Client auth0/spa: Skip, mode is Plan
+! A0Client auth0/legacy: Skip (found cl_legacy by name), removed from the manifests, but mode is Observe
+
+Plan: 0 to create, 0 to update, 0 to delete, 0 unchanged, 3 skipped.
+`
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	s := fake.NewUnstartedServer(fake.WithTenantSettings(fake.Object{"friendly_name": "Example"}))
+	s.StartTLS()
+	t.Cleanup(s.Close)
+
+	webID := s.Seed(fake.Clients, fake.Object{"name": "Web", "app_type": "regular_web", "client_secret": "s3cr3t"})
+	s.Seed(fake.Connections, fake.Object{"id": "con_1", "name": "google-oauth2", "strategy": "google-oauth2", "enabled_clients": []interface{}{webID}, "options": fake.Object{"client_id": "google", "client_secret": "g00gle"}})
+
+	access := management.NewTenantApiAccess(management.Credentials{Domain: s.Domain(), ClientID: s.ClientID, ClientSecret: s.ClientSecret}, management.WithHTTPClient(s.Client()))
+	snap, err := plan.Fetch(context.Background(), management.NewClient(access, s.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := snap.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "s3cr3t") || strings.Contains(out.String(), "g00gle") {
+		t.Errorf("expected secrets to be left out of the snapshot:\n%s", out.String())
+	}
+	web := snap.Clients[0]
+	if ids, _ := web["enabled_connections"].([]interface{}); len(ids) != 1 || ids[0] != "con_1" {
+		t.Errorf("expected the enabled connections of the client, got %v", web["enabled_connections"])
+	}
+}
+
+func TestPending(t *testing.T) {
+	id := "cl_web"
+	web := &v1.A0Client{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "auth0", Generation: 2},
+		Spec:       v1.A0ClientSpec{Mode: v1.ReconcileModePlan},
+		Status: v1.A0ClientStatus{Id: &id, Plan: plan.ToStatus(v1.PlanActionUpdate, []drift.Difference{
+			{Path: "logo_uri", Type: drift.Modified, Desired: "https://example.com/new.png", Live: "https://example.com/old.png"},
+			{Path: "callbacks[1]", Type: drift.Added, Desired: "https://www.example.com/cb"},
+		})},
+	}
+	web.Status.Plan.ObservedGeneration = 2
+	stale := &v1.A0ResourceServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "things", Namespace: "auth0", Generation: 3},
+		Spec:       v1.A0ResourceServerSpec{Mode: v1.ReconcileModePlan},
+		Status:     v1.A0ResourceServerStatus{Plan: &v1.V1Plan{Action: v1.PlanActionCreate, ObservedGeneration: 2}},
+	}
+	applied := &v1.A0Connection{ObjectMeta: metav1.ObjectMeta{Name: "database", Namespace: "auth0"}}
+
+	var out bytes.Buffer
+	if err := plan.Pending([]client.Object{web, stale, applied}).WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `! A0ResourceServer auth0/things: Skip, the plan is out of date with the spec
+~ A0Client auth0/web: Update (found cl_web by status.id)
+    ~ logo_uri: "https://example.com/old.png" -> "https://example.com/new.png"
+    + callbacks[1]: "https://www.example.com/cb"
+
+Plan: 0 to create, 1 to update, 0 to delete, 0 unchanged, 1 skipped.
+`
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
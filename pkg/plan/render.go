@@ -0,0 +1,51 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// symbols mark the action of each change in the text output, the way terraform plan does
+var symbols = map[Action]string{Create: "+", Update: "~", Delete: "-", NoChange: "=", Skip: "!"}
+
+// WriteText writes p for a reader: a line for each change, followed by its field-level changes, and a summary.
+func (p *Plan) WriteText(w io.Writer) error {
+	for _, c := range p.Changes {
+		line := fmt.Sprintf("%s %s %s: %s", symbols[c.Action], c.Kind, displayKey(c.Namespace, c.Name), c.Action)
+		switch {
+		case c.ID != "":
+			line += fmt.Sprintf(" (found %s by %s)", c.ID, c.FoundBy)
+		case c.FoundBy != "":
+			line += fmt.Sprintf(" (found by %s)", c.FoundBy)
+		}
+		if c.Reason != "" {
+			line += ", " + c.Reason
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+		for _, f := range c.Fields {
+			if _, err := fmt.Fprintf(w, "    %s\n", f); err != nil {
+				return err
+			}
+		}
+	}
+
+	s := p.Summary
+	if len(p.Changes) > 0 {
+		fmt.Fprintln(w)
+	}
+	_, err := fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete, %d unchanged, %d skipped.\n", s.Create, s.Update, s.Delete, s.NoChange, s.Skip)
+	return err
+}
+
+// WriteJSON writes p as indented JSON.
+func (p *Plan) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,135 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/seatgeek/auth0-operator/pkg/auth0/management"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// Snapshot is the state of the entities of a tenant that a plan is computed against, as returned by the Management
+// API. Clients hold the IDs of their enabled connections in enabled_connections, the way Status.LastConf does.
+type Snapshot struct {
+	Tenant          management.Object   `json:"tenant,omitempty"`
+	Clients         []management.Object `json:"clients"`
+	Connections     []management.Object `json:"connections"`
+	ResourceServers []management.Object `json:"resourceServers"`
+	ClientGrants    []management.Object `json:"clientGrants"`
+}
+
+// Fetch reads a Snapshot of the tenant of api. Secrets are left out, so that the snapshot can be kept as a file;
+// the plan does not report write-only secrets as changes anyway.
+func Fetch(ctx context.Context, api *management.Client) (*Snapshot, error) {
+	s := &Snapshot{}
+	var err error
+	if s.Tenant, err = api.GetTenantSettings(ctx); err != nil {
+		return nil, fmt.Errorf("failed to read tenant settings: %w", err)
+	}
+	if s.Connections, err = api.ListConnections(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+	if s.ResourceServers, err = api.ListResourceServers(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to list resource servers: %w", err)
+	}
+	if s.ClientGrants, err = api.ListClientGrants(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to list client grants: %w", err)
+	}
+	if s.Clients, err = api.ListClients(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to list clients: %w", err)
+	}
+	for _, c := range s.Clients {
+		id, _ := c["client_id"].(string)
+		if global, _ := c["global"].(bool); global {
+			continue
+		}
+		enabled, err := api.ListClientConnections(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the connections of client %s: %w", id, err)
+		}
+		ids := make([]interface{}, 0, len(enabled))
+		for _, conn := range enabled {
+			if connectionID, _ := conn["id"].(string); connectionID != "" {
+				ids = append(ids, connectionID)
+			}
+		}
+		c["enabled_connections"] = ids
+	}
+
+	for _, list := range [][]management.Object{{s.Tenant}, s.Clients, s.Connections, s.ResourceServers} {
+		for _, obj := range list {
+			withoutSecrets(obj)
+		}
+	}
+	return s, nil
+}
+
+// ReadSnapshot reads a Snapshot written by Write. When the connections have enabled_clients, clients without
+// enabled_connections get them from there, for exports of tools that only have the former.
+func ReadSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	hasEnabledClients := false
+	for _, conn := range s.Connections {
+		if _, ok := conn["enabled_clients"]; ok {
+			hasEnabledClients = true
+		}
+	}
+	for _, c := range s.Clients {
+		if _, ok := c["enabled_connections"]; ok || !hasEnabledClients {
+			continue
+		}
+		clientID, _ := c["client_id"].(string)
+		ids := []interface{}{}
+		for _, conn := range s.Connections {
+			enabled, _ := conn["enabled_clients"].([]interface{})
+			for _, id := range enabled {
+				if id == clientID {
+					ids = append(ids, conn["id"])
+				}
+			}
+		}
+		c["enabled_connections"] = ids
+	}
+	return s, nil
+}
+
+// Write writes s as indented JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// withoutSecrets deletes the string values of sensitive keys from obj and the objects it contains.
+func withoutSecrets(obj map[string]interface{}) {
+	for k, v := range obj {
+		switch t := v.(type) {
+		case string:
+			if drift.IsSensitiveKey(k) {
+				delete(obj, k)
+			}
+		case map[string]interface{}:
+			withoutSecrets(t)
+		case []interface{}:
+			for _, item := range t {
+				if m, ok := item.(map[string]interface{}); ok {
+					withoutSecrets(m)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package plan
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// planned is implemented by the A0* resources that can be reconciled in Plan mode
+type planned interface {
+	client.Object
+	GetMode() v1.V1ReconcileMode
+	GetPlan() *v1.V1Plan
+}
+
+// ToStatus returns the field-level changes as the plan recorded in the status of a resource. Values are stored as
+// JSON text, since a status field of the CRD cannot hold values of any type.
+func ToStatus(action v1.V1PlanAction, fields []drift.Difference) *v1.V1Plan {
+	p := &v1.V1Plan{Action: action}
+	for _, f := range fields {
+		p.Changes = append(p.Changes, v1.V1PlanChange{
+			Path:    f.Path,
+			Type:    string(f.Type),
+			Desired: jsonText(f.Desired),
+			Live:    jsonText(f.Live),
+		})
+	}
+	return p
+}
+
+// Pending returns the plans recorded in the status of the resources among objs that are in Plan mode, in the
+// order of the operator, so that they can be reviewed together before the resources are switched to Apply.
+// Resources in Plan mode that have not been reconciled yet are listed as skipped.
+func Pending(objs []client.Object) *Plan {
+	p := &Plan{}
+	for _, obj := range ordered(objs) {
+		r, ok := obj.(planned)
+		if !ok || r.GetMode() != v1.ReconcileModePlan {
+			continue
+		}
+		change := newChange(obj)
+		if entity, ok := obj.(v1.TenantEntity); ok {
+			change.ID = entity.GetAuth0ID()
+		}
+		status := r.GetPlan()
+		switch {
+		case status == nil:
+			change.Action, change.Reason = Skip, "no plan has been recorded yet"
+		case status.ObservedGeneration != 0 && status.ObservedGeneration != obj.GetGeneration():
+			change.Action, change.Reason = Skip, "the plan is out of date with the spec"
+		default:
+			change.Action, change.Reason = fromStatusAction(status.Action), status.Message
+			if change.ID != "" {
+				change.FoundBy = "status.id"
+			}
+			for _, c := range status.Changes {
+				change.Fields = append(change.Fields, drift.Difference{
+					Path:    c.Path,
+					Type:    drift.ChangeType(c.Type),
+					Desired: fromJSONText(c.Desired),
+					Live:    fromJSONText(c.Live),
+				})
+			}
+		}
+		p.add(change)
+	}
+	return p
+}
+
+// Stamp records the generation of obj and the current time in plan.
+func Stamp(plan *v1.V1Plan, obj metav1.Object) *v1.V1Plan {
+	now := metav1.Now()
+	plan.ObservedGeneration, plan.PlanTime = obj.GetGeneration(), &now
+	return plan
+}
+
+func fromStatusAction(action v1.V1PlanAction) Action {
+	switch action {
+	case v1.PlanActionCreate:
+		return Create
+	case v1.PlanActionUpdate:
+		return Update
+	default:
+		return NoChange
+	}
+}
+
+func jsonText(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func fromJSONText(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
@@ -0,0 +1,379 @@
+package tfstate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/importer"
+	"github.com/seatgeek/auth0-operator/pkg/manifest"
+)
+
+// schema describes how the attributes of a resource type map to its Conf
+type schema struct {
+	// renames maps attributes to the Conf fields with a different name
+	renames map[string]string
+
+	// handled are the attributes mapped outside of the Conf, such as IDs, which are not reported
+	handled []string
+
+	// readOnly are the attributes that Auth0 manages and that are reported without being mapped
+	readOnly []string
+}
+
+var schemas = map[string]schema{
+	"auth0_client": {
+		renames:  map[string]string{"cross_origin_auth": "cross_origin_authentication"},
+		handled:  []string{"id", "client_id"},
+		readOnly: []string{"client_secret", "signing_keys"},
+	},
+	"auth0_connection": {
+		handled: []string{"id", "enabled_clients"},
+	},
+	"auth0_resource_server": {
+		handled: []string{"id"},
+	},
+	"auth0_client_grant": {
+		renames: map[string]string{"scopes": "scope"},
+		handled: []string{"id", "client_id", "audience"},
+	},
+	"auth0_tenant": {
+		handled: []string{"id"},
+	},
+}
+
+// optionRenames maps the connection options of the provider to the names of the Management API
+var optionRenames = map[string]string{
+	"custom_scripts":                 "customScripts",
+	"password_policy":                "passwordPolicy",
+	"enabled_database_customization": "enabledDatabaseCustomization",
+}
+
+// listAttributes are the nested attributes that are lists of objects in the Management API. Other single element
+// lists of objects are Terraform blocks and become objects.
+var listAttributes = map[string]bool{
+	"scopes":                true,
+	"authorization_details": true,
+	"signing_keys":          true,
+	"credentials":           true,
+	"resource_servers":      true,
+}
+
+// Import returns the auth0_client, auth0_connection, auth0_resource_server, auth0_client_grant and auth0_tenant
+// resources of state as A0* resources, with a report of the attributes that were not mapped. Clients and connections
+// are found by their Auth0 ID, and references between the resources use the generated names.
+func Import(state *State, opts importer.Options) (*importer.Result, *Report, error) {
+	if opts.TenantName == "" {
+		return nil, nil, fmt.Errorf("a tenant name is required")
+	}
+	result := &importer.Result{}
+	report := &Report{}
+	names := manifest.NewNamer()
+	tenantRef := &v1.V1TenantReference{Name: opts.TenantName}
+
+	// instances of each type, in the order of the state
+	type instance struct {
+		address string
+		attrs   map[string]interface{}
+	}
+	instances := map[string][]instance{}
+	for i := range state.Resources {
+		r := &state.Resources[i]
+		if r.Mode != "managed" {
+			continue
+		}
+		for n := range r.Instances {
+			if _, ok := schemas[r.Type]; !ok {
+				report.Skipped = append(report.Skipped, r.address(n))
+				continue
+			}
+			instances[r.Type] = append(instances[r.Type], instance{address: r.address(n), attrs: r.Instances[n].Attributes})
+		}
+	}
+
+	domain := opts.Domain
+	result.Tenant = &v1.A0Tenant{
+		TypeMeta:   typeMeta("A0Tenant"),
+		ObjectMeta: objectMeta(opts.TenantName, opts.Namespace),
+		Spec: v1.A0TenantSpec{
+			Name: opts.TenantName,
+			Auth: &v1.TenantAuth{Domain: &domain, SecretRef: &v1.V1SecretReference{Name: opts.SecretName, Namespace: opts.Namespace}},
+			Conf: &v1.TenantConf{},
+		},
+	}
+	for n, in := range instances["auth0_tenant"] {
+		if n > 0 {
+			report.Skipped = append(report.Skipped, in.address+" (only the first auth0_tenant is imported)")
+			continue
+		}
+		report.decode(in.address, "auth0_tenant", in.attrs, result.Tenant.Spec.Conf)
+	}
+
+	clients := map[string]*v1.A0Client{}
+	for _, in := range instances["auth0_client"] {
+		id, _ := in.attrs["client_id"].(string)
+		if id == "" {
+			id, _ = in.attrs["id"].(string)
+		}
+		obj := &v1.A0Client{
+			TypeMeta:   typeMeta("A0Client"),
+			ObjectMeta: objectMeta(names.Next("client", in.attrs["name"], id), opts.Namespace),
+			Spec:       v1.A0ClientSpec{TenantRef: tenantRef, Find: &v1.ClientFind{ClientId: &id}, Conf: &v1.ClientConf{}},
+		}
+		report.decode(in.address, "auth0_client", in.attrs, obj.Spec.Conf)
+		clients[id] = obj
+		result.Clients = append(result.Clients, obj)
+	}
+
+	for _, in := range instances["auth0_connection"] {
+		id, _ := in.attrs["id"].(string)
+		obj := &v1.A0Connection{
+			TypeMeta:   typeMeta("A0Connection"),
+			ObjectMeta: objectMeta(names.Next("connection", in.attrs["name"], id), opts.Namespace),
+			Spec:       v1.A0ConnectionSpec{TenantRef: tenantRef, Find: &v1.ConnectionFind{ConnectionId: &id}, Conf: &v1.ConnectionConf{}},
+		}
+		attrs := in.attrs
+		if options, ok := normalize(attrs["options"], "options"); ok {
+			attrs = copyWith(attrs, "options", connectionOptions(options))
+		}
+		report.decode(in.address, "auth0_connection", attrs, obj.Spec.Conf)
+		for _, err := range obj.Spec.Conf.ValidateOptions(nil) {
+			report.unmapped(in.address, err.Field, err.ErrorBody())
+		}
+		result.Connections = append(result.Connections, obj)
+
+		// enabled_clients is an attribute of auth0_connection up to version 1 of the provider
+		enabled, _ := attrs["enabled_clients"].([]interface{})
+		for _, e := range enabled {
+			clientID, _ := e.(string)
+			a0client, ok := clients[clientID]
+			if !ok {
+				report.unmapped(in.address, "enabled_clients", fmt.Sprintf("client %s is not in the state", clientID))
+				continue
+			}
+			name := obj.Name
+			a0client.Spec.Conf.EnabledConnections = append(a0client.Spec.Conf.EnabledConnections, v1.V1ConnectionReference{Name: &name})
+		}
+	}
+	for _, a0client := range result.Clients {
+		refs := a0client.Spec.Conf.EnabledConnections
+		sort.Slice(refs, func(i, j int) bool { return *refs[i].Name < *refs[j].Name })
+	}
+
+	audiences := map[string]string{}
+	for _, in := range instances["auth0_resource_server"] {
+		identifier, _ := in.attrs["identifier"].(string)
+		obj := &v1.A0ResourceServer{
+			TypeMeta:   typeMeta("A0ResourceServer"),
+			ObjectMeta: objectMeta(names.Next("resourceserver", in.attrs["name"], identifier), opts.Namespace),
+			Spec:       v1.A0ResourceServerSpec{TenantRef: tenantRef, Conf: &v1.ResourceServerConf{}},
+		}
+		report.decode(in.address, "auth0_resource_server", in.attrs, obj.Spec.Conf)
+		audiences[strings.ToLower(identifier)] = obj.Name
+		result.ResourceServers = append(result.ResourceServers, obj)
+	}
+
+	for _, in := range instances["auth0_client_grant"] {
+		clientID, _ := in.attrs["client_id"].(string)
+		audience, _ := in.attrs["audience"].(string)
+		conf := &v1.ClientGrantConf{}
+		report.decode(in.address, "auth0_client_grant", in.attrs, conf)
+
+		grantName := clientID
+		if a0client, ok := clients[clientID]; ok {
+			conf.ClientRef = &v1.V1ClientReference{Name: &a0client.Name}
+			grantName = a0client.Name
+		} else {
+			conf.ClientRef = &v1.V1ClientReference{Id: &clientID}
+		}
+		if name, ok := audiences[strings.ToLower(audience)]; ok {
+			conf.Audience = &v1.V1ResourceServerReference{Name: &name}
+			grantName += "-" + name
+		} else {
+			conf.Audience = &v1.V1ResourceServerReference{Identifier: &audience}
+			grantName += "-" + audience
+		}
+
+		result.ClientGrants = append(result.ClientGrants, &v1.A0ClientGrant{
+			TypeMeta:   typeMeta("A0ClientGrant"),
+			ObjectMeta: objectMeta(names.Next("clientgrant", grantName, ""), opts.Namespace),
+			Spec:       v1.A0ClientGrantSpec{TenantRef: tenantRef, Conf: conf},
+		})
+	}
+	sort.Slice(result.ClientGrants, func(i, j int) bool { return result.ClientGrants[i].Name < result.ClientGrants[j].Name })
+
+	return result, report, nil
+}
+
+// decode maps the attributes of a resource of type typ to conf one at a time, and reports the attributes, or the
+// nested attributes, that conf cannot represent.
+func (r *Report) decode(address, typ string, attrs map[string]interface{}, conf interface{}) {
+	s := schemas[typ]
+	skip := map[string]string{}
+	for _, a := range s.handled {
+		skip[a] = ""
+	}
+	for _, a := range s.readOnly {
+		skip[a] = "read-only in Auth0"
+	}
+
+	for _, key := range sortedKeys(attrs) {
+		if reason, ok := skip[key]; ok {
+			if _, set := normalize(attrs[key], key); set && reason != "" {
+				r.unmapped(address, key, reason)
+			}
+			continue
+		}
+		value, ok := normalize(attrs[key], key)
+		if !ok {
+			continue
+		}
+		field := key
+		if renamed, ok := s.renames[key]; ok {
+			field = renamed
+		}
+
+		data, err := json.Marshal(map[string]interface{}{field: value})
+		if err != nil {
+			r.unmapped(address, key, err.Error())
+			continue
+		}
+
+		// decode into an empty Conf first to find what the Conf cannot represent
+		fresh := reflect.New(reflect.TypeOf(conf).Elem()).Interface()
+		if err := json.Unmarshal(data, fresh); err != nil {
+			r.unmapped(address, key, err.Error())
+			continue
+		}
+		mapped, _ := toMap(fresh)
+		if _, ok := mapped[field]; !ok {
+			r.unmapped(address, key, "no such field in the A0* type")
+			continue
+		}
+		for _, path := range missing(value, mapped[field], key) {
+			r.unmapped(address, path, "no such field in the A0* type")
+		}
+		_ = json.Unmarshal(data, conf)
+	}
+}
+
+// normalize drops the empty values Terraform stores for unset attributes and turns blocks, which Terraform stores
+// as single element lists, into objects. It reports false when nothing is left.
+func normalize(v interface{}, key string) (interface{}, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k, e := range v {
+			if n, ok := normalize(e, k); ok {
+				out[k] = n
+			}
+		}
+		return out, len(out) > 0
+	case []interface{}:
+		if len(v) == 1 && !listAttributes[key] {
+			if _, ok := v[0].(map[string]interface{}); ok {
+				return normalize(v[0], key)
+			}
+		}
+		var out []interface{}
+		for _, e := range v {
+			if n, ok := normalize(e, ""); ok {
+				out = append(out, n)
+			}
+		}
+		return out, len(out) > 0
+	}
+	return v, true
+}
+
+// connectionOptions renames the options of the provider to the names of the Management API and decodes the options
+// that the provider stores as JSON documents.
+func connectionOptions(v interface{}) interface{} {
+	options, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	out := map[string]interface{}{}
+	for k, e := range options {
+		if renamed, ok := optionRenames[k]; ok {
+			k = renamed
+		}
+		if s, ok := e.(string); ok && k == "upstream_params" {
+			var doc interface{}
+			if json.Unmarshal([]byte(s), &doc) == nil {
+				e = doc
+			}
+		}
+		out[k] = e
+	}
+	return out
+}
+
+// missing returns the paths of the nested attributes of in that are not in out.
+func missing(in, out interface{}, path string) []string {
+	var paths []string
+	switch in := in.(type) {
+	case map[string]interface{}:
+		outMap, _ := out.(map[string]interface{})
+		for _, k := range sortedKeys(in) {
+			e, ok := outMap[k]
+			if !ok {
+				paths = append(paths, join(path, k))
+				continue
+			}
+			paths = append(paths, missing(in[k], e, join(path, k))...)
+		}
+	case []interface{}:
+		outList, _ := out.([]interface{})
+		for i, e := range in {
+			if i < len(outList) {
+				paths = append(paths, missing(e, outList[i], join(path, fmt.Sprintf("[%d]", i)))...)
+			}
+		}
+	}
+	return paths
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	return out, json.Unmarshal(data, &out)
+}
+
+func copyWith(attrs map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(attrs))
+	for k, v := range attrs {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: v1.GroupVersion.String(), Kind: kind}
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: namespace}
+}
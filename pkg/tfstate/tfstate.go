@@ -0,0 +1,138 @@
+// Package tfstate generates A0* resources from the Auth0 resources of a Terraform state file, so that tenants managed
+// with the Terraform auth0 provider can move to the operator. It reads the state file only and does not call Auth0.
+package tfstate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// State is the subset of a Terraform state file, format version 4, that holds the resources
+type State struct {
+	Version   int        `json:"version"`
+	Resources []Resource `json:"resources"`
+}
+
+// Resource is a resource block of the state, with an instance for each count or for_each key
+type Resource struct {
+	Module    string     `json:"module,omitempty"`
+	Mode      string     `json:"mode"`
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	Instances []Instance `json:"instances"`
+}
+
+// Instance is an instance of a resource with the attributes the provider last read
+type Instance struct {
+	IndexKey   interface{}            `json:"index_key,omitempty"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// Read reads a terraform.tfstate file.
+func Read(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	state := &State{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("%s: unsupported state version %d, expected 4", path, state.Version)
+	}
+	return state, nil
+}
+
+// address returns the Terraform address of instance i of r, e.g. module.auth0.auth0_client.web["spa"].
+func (r *Resource) address(i int) string {
+	addr := r.Type + "." + r.Name
+	if r.Mode == "data" {
+		addr = "data." + addr
+	}
+	if r.Module != "" {
+		addr = r.Module + "." + addr
+	}
+	switch key := r.Instances[i].IndexKey.(type) {
+	case string:
+		addr += fmt.Sprintf("[%q]", key)
+	case float64:
+		addr += fmt.Sprintf("[%d]", int(key))
+	}
+	return addr
+}
+
+// Report lists what the generated resources leave out
+type Report struct {
+	// Unmapped lists the attributes of imported resources that are not in the generated resources
+	Unmapped []Unmapped
+
+	// Skipped lists the addresses of the managed resources that have no A0* kind
+	Skipped []string
+}
+
+// Unmapped is an attribute of a Terraform resource that is not in the generated resource
+type Unmapped struct {
+	// Address is the Terraform address of the resource
+	Address string
+
+	// Attribute is the path of the attribute, e.g. "jwt_configuration.scopes"
+	Attribute string
+
+	// Reason says why the attribute was not mapped
+	Reason string
+}
+
+// Empty reports whether r lists nothing.
+func (r *Report) Empty() bool {
+	return len(r.Unmapped) == 0 && len(r.Skipped) == 0
+}
+
+// Write writes r as a table of unmapped attributes followed by the skipped resources.
+func (r *Report) Write(w io.Writer) error {
+	if r.Empty() {
+		_, err := fmt.Fprintln(w, "All attributes were mapped.")
+		return err
+	}
+	if len(r.Unmapped) > 0 {
+		tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "RESOURCE\tATTRIBUTE\tREASON")
+		for _, u := range r.Unmapped {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", u.Address, u.Attribute, u.Reason)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(r.Skipped) > 0 {
+		if len(r.Unmapped) > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, "Resources without an A0* kind:")
+		for _, addr := range r.Skipped {
+			if _, err := fmt.Fprintf(w, "  %s\n", addr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Report) unmapped(address, attribute, reason string) {
+	r.Unmapped = append(r.Unmapped, Unmapped{Address: address, Attribute: attribute, Reason: reason})
+}
+
+// join appends key to the attribute path parent.
+func join(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	if strings.HasPrefix(key, "[") {
+		return parent + key
+	}
+	return parent + "." + key
+}
@@ -0,0 +1,89 @@
+package tfstate_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/seatgeek/auth0-operator/pkg/importer"
+	"github.com/seatgeek/auth0-operator/pkg/tfstate"
+)
+
+func TestImport(t *testing.T) {
+	state, err := tfstate.Read("testdata/terraform.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, report, err := tfstate.Import(state, importer.Options{Namespace: "auth0", TenantName: "example", Domain: "example.eu.auth0.com", SecretName: "example-auth0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := *result.Tenant.Spec.Conf.SessionLifetime; got != 168 {
+		t.Errorf("expected the session lifetime of auth0_tenant, got %d", got)
+	}
+	if flags := result.Tenant.Spec.Conf.Flags; flags == nil || flags.EnableClientConnections == nil || *flags.EnableClientConnections {
+		t.Errorf("expected the flags block as an object, got %+v", flags)
+	}
+
+	if len(result.Clients) != 2 {
+		t.Fatalf("expected both instances of auth0_client.apps and no data source, got %d clients", len(result.Clients))
+	}
+	web := result.Clients[0]
+	if web.Name != "web-app" || *web.Spec.Find.ClientId != "cl_web" {
+		t.Errorf("unexpected client %s with find %+v", web.Name, web.Spec.Find)
+	}
+	if conf := web.Spec.Conf; conf.CrossOriginAuthentication == nil || !*conf.CrossOriginAuthentication || *conf.JwtConfiguration.LifetimeInSeconds != 36000 || conf.AllowedOrigins != nil {
+		t.Errorf("unexpected client conf %+v", conf)
+	}
+	if refs := web.Spec.Conf.EnabledConnections; len(refs) != 1 || *refs[0].Name != "username-password-authentication" {
+		t.Errorf("expected the enabled_clients of the connection on the client, got %+v", refs)
+	}
+
+	connection := result.Connections[0]
+	opts, err := connection.Spec.Conf.GetOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.PasswordPolicy == nil || *opts.PasswordPolicy != "good" || opts.CustomScripts == nil || opts.CustomScripts.GetUser == nil || opts.UpstreamParams == nil {
+		t.Errorf("expected the options with the names of the Management API, got %s", connection.Spec.Conf.Options.Raw)
+	}
+
+	if len(result.ClientGrants) != 2 {
+		t.Fatalf("expected 2 client grants, got %d", len(result.ClientGrants))
+	}
+	grant := result.ClientGrants[1]
+	if grant.Name != "worker-things-api" || *grant.Spec.Conf.ClientRef.Name != "worker" || *grant.Spec.Conf.Audience.Name != "things-api" || grant.Spec.Conf.Scope[0] != "read:things" {
+		t.Errorf("expected the grant to reference the generated resources, got %s %+v", grant.Name, grant.Spec.Conf)
+	}
+
+	var out bytes.Buffer
+	if err := report.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `RESOURCE                                   ATTRIBUTE                            REASON
+auth0_tenant.tenant                        default_redirection_uri              no such field in the A0* type
+auth0_tenant.tenant                        idle_session_lifetime                json: cannot unmarshal number 0.5 into Go struct field TenantConf.idle_session_lifetime of type int32
+auth0_client.apps["web"]                   client_secret                        read-only in Auth0
+auth0_client.apps["web"]                   is_token_endpoint_ip_header_trusted  no such field in the A0* type
+auth0_client.apps["web"]                   native_social_login                  no such field in the A0* type
+module.identity.auth0_connection.database  enabled_clients                      client cl_gone is not in the state
+
+Resources without an A0* kind:
+  auth0_action.login
+`
+	if out.String() != want {
+		t.Errorf("got report:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestReadVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terraform.tfstate")
+	if err := os.WriteFile(path, []byte(`{"version": 3, "modules": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tfstate.Read(path); err == nil {
+		t.Error("expected an error for a version 3 state")
+	}
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// +kubebuilder:webhook:path=/mutate-kubernetes-auth0-com-v1-a0client,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0clients,verbs=create;update,versions=v1,name=ma0client.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubernetes-auth0-com-v1-a0connection,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0connections,verbs=create;update,versions=v1,name=ma0connection.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubernetes-auth0-com-v1-a0clientgrant,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0clientgrants,verbs=create;update,versions=v1,name=ma0clientgrant.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubernetes-auth0-com-v1-a0resourceserver,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0resourceservers,verbs=create;update,versions=v1,name=ma0resourceserver.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubernetes-auth0-com-v1-a0tenant,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0tenants,verbs=create;update,versions=v1,name=ma0tenant.kubernetes.auth0.com,admissionReviewVersions=v1
+
+// Defaultable is implemented by the A0* resources that can set their own default values
+type Defaultable interface {
+	client.Object
+	Default()
+}
+
+// Defaulter is a defaulting admission webhook for a single A0* kind
+type Defaulter[T Defaultable] struct{}
+
+var _ webhook.CustomDefaulter = &Defaulter[*v1.A0Client]{}
+
+// Default implements webhook.CustomDefaulter
+func (d *Defaulter[T]) Default(ctx context.Context, obj runtime.Object) error {
+	o, ok := obj.(T)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("unexpected object type %T", obj))
+	}
+
+	// objects created without metadata.namespace take the namespace of the request
+	if o.GetNamespace() == "" {
+		if req, err := admission.RequestFromContext(ctx); err == nil {
+			o.SetNamespace(req.Namespace)
+		}
+	}
+
+	o.Default()
+	return nil
+}
+
+// SetupDefaultingWebhooksWithManager registers the defaulting webhooks of all A0* kinds with mgr
+func SetupDefaultingWebhooksWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0Client{}).WithDefaulter(&Defaulter[*v1.A0Client]{}).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0Connection{}).WithDefaulter(&Defaulter[*v1.A0Connection]{}).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0ClientGrant{}).WithDefaulter(&Defaulter[*v1.A0ClientGrant]{}).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0ResourceServer{}).WithDefaulter(&Defaulter[*v1.A0ResourceServer]{}).Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&v1.A0Tenant{}).WithDefaulter(&Defaulter[*v1.A0Tenant]{}).Complete()
+}
@@ -0,0 +1,61 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/webhook"
+)
+
+func TestDefaulterNamespaceFromRequest(t *testing.T) {
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Namespace: "apps"},
+	})
+	obj := &v1.A0ClientGrant{Spec: v1.A0ClientGrantSpec{
+		TenantRef: tenantRef(),
+		Conf:      &v1.ClientGrantConf{ClientRef: &v1.V1ClientReference{Name: strPtr("web")}},
+	}}
+
+	if err := (&webhook.Defaulter[*v1.A0ClientGrant]{}).Default(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Namespace != "apps" {
+		t.Errorf("namespace = %q, want the namespace of the request", obj.Namespace)
+	}
+	if ns := obj.Spec.TenantRef.Namespace; ns == nil || *ns != "apps" {
+		t.Errorf("tenantRef.namespace = %v, want apps", ns)
+	}
+	if ns := obj.Spec.Conf.ClientRef.Namespace; ns == nil || *ns != "apps" {
+		t.Errorf("conf.clientRef.namespace = %v, want apps", ns)
+	}
+}
+
+func TestDefaulterKeepsObjectNamespace(t *testing.T) {
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Namespace: "apps"},
+	})
+	obj := &v1.A0Client{Spec: v1.A0ClientSpec{TenantRef: tenantRef()}}
+	obj.Namespace = namespace
+
+	if err := (&webhook.Defaulter[*v1.A0Client]{}).Default(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if ns := obj.Spec.TenantRef.Namespace; obj.Namespace != namespace || ns == nil || *ns != namespace {
+		t.Errorf("got namespace %q and tenantRef.namespace %v, want %s", obj.Namespace, ns, namespace)
+	}
+	if len(obj.Spec.Policy) != 2 {
+		t.Errorf("policy = %v, want the default policy", obj.Spec.Policy)
+	}
+}
+
+func TestDefaulterRejectsOtherKinds(t *testing.T) {
+	err := (&webhook.Defaulter[*v1.A0Client]{}).Default(context.Background(), &v1.A0Tenant{})
+	if !apierrors.IsBadRequest(err) {
+		t.Errorf("expected a BadRequest error, got %v", err)
+	}
+}
@@ -0,0 +1,361 @@
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/drift"
+)
+
+// Callback URL match modes supported by ClientFind
+const (
+	CallbackUrlMatchModeStrict = "strict"
+	CallbackUrlMatchModeLoose  = "loose"
+)
+
+// ValidateA0Client validates the cross-field rules of an A0Client
+func ValidateA0Client(obj *v1.A0Client) field.ErrorList {
+	spec := &obj.Spec
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicy(spec.Policy, specPath.Child("policy"))
+	allErrs = append(allErrs, validateTenantRef(spec.TenantRef, specPath.Child("tenantRef"))...)
+	allErrs = append(allErrs, validateIgnoreFields(spec.IgnoreFields, specPath.Child("ignoreFields"))...)
+	allErrs = append(allErrs, validateSecretRef(spec.SecretRef, specPath.Child("secretRef"))...)
+	allErrs = append(allErrs, validateClientFind(spec.Find, specPath.Child("find"))...)
+
+	if spec.Conf == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("conf"), ""))
+	} else {
+		allErrs = append(allErrs, validateClientConf(spec.Conf, specPath.Child("conf"))...)
+	}
+	if spec.Init != nil {
+		allErrs = append(allErrs, validateClientConf(spec.Init, specPath.Child("init"))...)
+	}
+
+	// the operator creates the client from Init, falling back to Conf
+	if hasPolicy(spec.Policy, v1.PolicyTypeCreate) {
+		if init, initPath := initOrConf(spec.Init, spec.Conf, specPath); init != nil && init.ApplicationType == nil {
+			allErrs = append(allErrs, field.Required(initPath.Child("app_type"), "an application type is required to create the client"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateA0Connection validates the cross-field rules of an A0Connection
+func ValidateA0Connection(obj *v1.A0Connection) field.ErrorList {
+	spec := &obj.Spec
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicy(spec.Policy, specPath.Child("policy"))
+	allErrs = append(allErrs, validateTenantRef(spec.TenantRef, specPath.Child("tenantRef"))...)
+	allErrs = append(allErrs, validateIgnoreFields(spec.IgnoreFields, specPath.Child("ignoreFields"))...)
+
+	if spec.Conf == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("conf"), ""))
+	} else {
+		allErrs = append(allErrs, spec.Conf.ValidateOptions(specPath.Child("conf"))...)
+	}
+
+	if spec.Init != nil {
+		initPath := specPath.Child("init")
+		allErrs = append(allErrs, spec.Init.ValidateOptions(initPath)...)
+
+		// the name and strategy of a connection cannot be changed once it has been created
+		if spec.Conf != nil {
+			allErrs = append(allErrs, validateSameString(spec.Init.Name, spec.Conf.Name, initPath.Child("name"), "conf.name")...)
+			allErrs = append(allErrs, validateSameString(spec.Init.Strategy, spec.Conf.Strategy, initPath.Child("strategy"), "conf.strategy")...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateA0ClientGrant validates the cross-field rules of an A0ClientGrant
+func ValidateA0ClientGrant(obj *v1.A0ClientGrant) field.ErrorList {
+	spec := &obj.Spec
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicy(spec.Policy, specPath.Child("policy"))
+	allErrs = append(allErrs, validateTenantRef(spec.TenantRef, specPath.Child("tenantRef"))...)
+	allErrs = append(allErrs, validateIgnoreFields(spec.IgnoreFields, specPath.Child("ignoreFields"))...)
+
+	if spec.Conf == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("conf"), ""))
+	} else {
+		allErrs = append(allErrs, validateClientGrantConf(spec.Conf, specPath.Child("conf"))...)
+	}
+
+	if spec.Init != nil {
+		initPath := specPath.Child("init")
+		allErrs = append(allErrs, validateClientGrantConf(spec.Init, initPath)...)
+
+		// a grant is identified by its client and audience, which cannot differ between Init and Conf
+		if spec.Conf != nil {
+			allErrs = append(allErrs, validateSameClientRef(spec.Init.ClientRef, spec.Conf.ClientRef, initPath.Child("clientRef"))...)
+			allErrs = append(allErrs, validateSameAudience(spec.Init.Audience, spec.Conf.Audience, initPath.Child("audience"))...)
+		}
+	}
+
+	if hasPolicy(spec.Policy, v1.PolicyTypeCreate) {
+		if init, initPath := initOrConf(spec.Init, spec.Conf, specPath); init != nil && init.Scope == nil {
+			allErrs = append(allErrs, field.Required(initPath.Child("scope"), "a scope is required to create the client grant"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateA0ResourceServer validates the cross-field rules of an A0ResourceServer
+func ValidateA0ResourceServer(obj *v1.A0ResourceServer) field.ErrorList {
+	spec := &obj.Spec
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicy(spec.Policy, specPath.Child("policy"))
+	allErrs = append(allErrs, validateTenantRef(spec.TenantRef, specPath.Child("tenantRef"))...)
+	allErrs = append(allErrs, validateIgnoreFields(spec.IgnoreFields, specPath.Child("ignoreFields"))...)
+
+	if spec.Conf == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("conf"), ""))
+	} else {
+		allErrs = append(allErrs, validateResourceServerConf(spec.Conf, specPath.Child("conf"))...)
+	}
+
+	if spec.Init != nil {
+		initPath := specPath.Child("init")
+		allErrs = append(allErrs, validateResourceServerConf(spec.Init, initPath)...)
+
+		// the identifier of a resource server cannot be changed once it has been created
+		if spec.Conf != nil {
+			allErrs = append(allErrs, validateSameString(spec.Init.Identifier, spec.Conf.Identifier, initPath.Child("identifier"), "conf.identifier")...)
+		}
+	}
+
+	if hasPolicy(spec.Policy, v1.PolicyTypeCreate) {
+		if init, initPath := initOrConf(spec.Init, spec.Conf, specPath); init != nil && !isSet(init.Identifier) {
+			allErrs = append(allErrs, field.Required(initPath.Child("identifier"), "an identifier is required to create the resource server"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateA0Tenant validates the cross-field rules of an A0Tenant
+func ValidateA0Tenant(obj *v1.A0Tenant) field.ErrorList {
+	spec := &obj.Spec
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicy(spec.Policy, specPath.Child("policy"))
+
+	if spec.Auth == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("auth"), ""))
+	} else {
+		authPath := specPath.Child("auth")
+		if !isSet(spec.Auth.Domain) {
+			allErrs = append(allErrs, field.Required(authPath.Child("domain"), ""))
+		}
+		if spec.Auth.SecretRef == nil {
+			allErrs = append(allErrs, field.Required(authPath.Child("secretRef"), ""))
+		} else {
+			allErrs = append(allErrs, validateSecretRef(spec.Auth.SecretRef, authPath.Child("secretRef"))...)
+		}
+	}
+
+	if spec.Conf == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("conf"), ""))
+	}
+
+	return allErrs
+}
+
+func validateClientConf(conf *v1.ClientConf, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i := range conf.EnabledConnections {
+		allErrs = append(allErrs, validateConnectionRef(&conf.EnabledConnections[i], fldPath.Child("enabled_connections").Index(i))...)
+	}
+	return allErrs
+}
+
+func validateClientFind(find *v1.ClientFind, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if find == nil {
+		return allErrs
+	}
+
+	hasCallbackUrls := len(find.CallbackUrls) > 0
+	if isSet(find.ClientId) && hasCallbackUrls {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("callback_urls"), "may not be specified together with client_id"))
+	}
+	if !isSet(find.ClientId) && !hasCallbackUrls {
+		allErrs = append(allErrs, field.Required(fldPath, "one of client_id or callback_urls is required"))
+	}
+
+	if find.CallbackUrlMatchMode != nil {
+		mode := fldPath.Child("callback_url_match_mode")
+		if !hasCallbackUrls {
+			allErrs = append(allErrs, field.Forbidden(mode, "may only be specified together with callback_urls"))
+		}
+		if *find.CallbackUrlMatchMode != CallbackUrlMatchModeStrict && *find.CallbackUrlMatchMode != CallbackUrlMatchModeLoose {
+			allErrs = append(allErrs, field.NotSupported(mode, *find.CallbackUrlMatchMode, []string{CallbackUrlMatchModeStrict, CallbackUrlMatchModeLoose}))
+		}
+	}
+
+	return allErrs
+}
+
+func validateClientGrantConf(conf *v1.ClientGrantConf, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.ClientRef == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientRef"), ""))
+	} else {
+		refPath := fldPath.Child("clientRef")
+		switch {
+		case !isSet(conf.ClientRef.Name) && !isSet(conf.ClientRef.Id):
+			allErrs = append(allErrs, field.Required(refPath, "one of name or id is required"))
+		case isSet(conf.ClientRef.Name) && isSet(conf.ClientRef.Id):
+			allErrs = append(allErrs, field.Forbidden(refPath.Child("id"), "may not be specified together with name"))
+		}
+	}
+
+	if conf.Audience == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("audience"), ""))
+	} else {
+		refPath := fldPath.Child("audience")
+		switch {
+		case !isSet(conf.Audience.Identifier) && !isSet(conf.Audience.Name) && !isSet(conf.Audience.Id):
+			allErrs = append(allErrs, field.Required(refPath, "one of identifier, name or id is required"))
+		case isSet(conf.Audience.Name) && isSet(conf.Audience.Id):
+			allErrs = append(allErrs, field.Forbidden(refPath.Child("id"), "may not be specified together with name"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateResourceServerConf(conf *v1.ResourceServerConf, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := map[string]bool{}
+	for i, scope := range conf.Scopes {
+		scopePath := fldPath.Child("scopes").Index(i).Child("value")
+		if !isSet(scope.Value) {
+			allErrs = append(allErrs, field.Required(scopePath, ""))
+			continue
+		}
+		if seen[*scope.Value] {
+			allErrs = append(allErrs, field.Duplicate(scopePath, *scope.Value))
+		}
+		seen[*scope.Value] = true
+	}
+
+	return allErrs
+}
+
+func validatePolicy(policy []v1.V1EntityPolicyType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := map[v1.V1EntityPolicyType]bool{}
+	for i, p := range policy {
+		if seen[p] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), p))
+		}
+		seen[p] = true
+	}
+	return allErrs
+}
+
+func validateIgnoreFields(paths []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, p := range paths {
+		if err := drift.ValidateFieldPath(p); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), p, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateTenantRef(ref *v1.V1TenantReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ref == nil {
+		allErrs = append(allErrs, field.Required(fldPath, ""))
+	} else if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	return allErrs
+}
+
+func validateSecretRef(ref *v1.V1SecretReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ref != nil && ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	return allErrs
+}
+
+func validateConnectionRef(ref *v1.V1ConnectionReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch {
+	case !isSet(ref.Name) && !isSet(ref.Id):
+		allErrs = append(allErrs, field.Required(fldPath, "one of name or id is required"))
+	case isSet(ref.Name) && isSet(ref.Id):
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("id"), "may not be specified together with name"))
+	}
+	return allErrs
+}
+
+func validateSameClientRef(init, conf *v1.V1ClientReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if init == nil || conf == nil {
+		return allErrs
+	}
+	allErrs = append(allErrs, validateSameString(init.Name, conf.Name, fldPath.Child("name"), "conf.clientRef.name")...)
+	allErrs = append(allErrs, validateSameString(init.Id, conf.Id, fldPath.Child("id"), "conf.clientRef.id")...)
+	return allErrs
+}
+
+func validateSameAudience(init, conf *v1.V1ResourceServerReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if init == nil || conf == nil {
+		return allErrs
+	}
+	allErrs = append(allErrs, validateSameString(init.Identifier, conf.Identifier, fldPath.Child("identifier"), "conf.audience.identifier")...)
+	allErrs = append(allErrs, validateSameString(init.Name, conf.Name, fldPath.Child("name"), "conf.audience.name")...)
+	allErrs = append(allErrs, validateSameString(init.Id, conf.Id, fldPath.Child("id"), "conf.audience.id")...)
+	return allErrs
+}
+
+// validateSameString reports an error if both values are set and differ.
+func validateSameString(init, conf *string, fldPath *field.Path, confName string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if isSet(init) && isSet(conf) && *init != *conf {
+		allErrs = append(allErrs, field.Invalid(fldPath, *init, "must match "+confName))
+	}
+	return allErrs
+}
+
+// initOrConf returns the configuration the operator creates the entity from, along with its path.
+func initOrConf[T any](init, conf *T, specPath *field.Path) (*T, *field.Path) {
+	if init != nil {
+		return init, specPath.Child("init")
+	}
+	return conf, specPath.Child("conf")
+}
+
+// hasPolicy reports whether policy allows the given operation.
+// A nil policy allows creating and updating the entity.
+func hasPolicy(policy []v1.V1EntityPolicyType, p v1.V1EntityPolicyType) bool {
+	if policy == nil {
+		return p == v1.PolicyTypeCreate || p == v1.PolicyTypeUpdate
+	}
+	for _, v := range policy {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+func isSet(s *string) bool {
+	return s != nil && *s != ""
+}
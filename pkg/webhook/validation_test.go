@@ -0,0 +1,337 @@
+package webhook_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+	"github.com/seatgeek/auth0-operator/pkg/webhook"
+)
+
+func TestValidateA0Client(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec v1.A0ClientSpec
+		want []string
+	}{
+		{
+			name: "valid",
+			spec: v1.A0ClientSpec{TenantRef: tenantRef(), Conf: &v1.ClientConf{ApplicationType: strPtr("spa")}},
+		},
+		{
+			name: "missing tenant and conf",
+			want: []string{"spec.tenantRef", "spec.conf"},
+		},
+		{
+			name: "duplicate policy",
+			spec: v1.A0ClientSpec{
+				TenantRef: tenantRef(),
+				Policy:    []v1.V1EntityPolicyType{v1.PolicyTypeUpdate, v1.PolicyTypeUpdate},
+				Conf:      &v1.ClientConf{},
+			},
+			want: []string{"spec.policy[1]"},
+		},
+		{
+			name: "app_type required to create",
+			spec: v1.A0ClientSpec{TenantRef: tenantRef(), Conf: &v1.ClientConf{}},
+			want: []string{"spec.conf.app_type"},
+		},
+		{
+			name: "app_type taken from init",
+			spec: v1.A0ClientSpec{
+				TenantRef: tenantRef(),
+				Init:      &v1.ClientConf{},
+				Conf:      &v1.ClientConf{ApplicationType: strPtr("spa")},
+			},
+			want: []string{"spec.init.app_type"},
+		},
+		{
+			name: "incomplete connection references",
+			spec: v1.A0ClientSpec{
+				TenantRef: tenantRef(),
+				Policy:    []v1.V1EntityPolicyType{v1.PolicyTypeUpdate},
+				Conf: &v1.ClientConf{EnabledConnections: []v1.V1ConnectionReference{
+					{Name: strPtr("db")},
+					{},
+					{Name: strPtr("db"), Id: strPtr("con_1")},
+				}},
+			},
+			want: []string{"spec.conf.enabled_connections[1]", "spec.conf.enabled_connections[2].id"},
+		},
+		{
+			name: "find by client_id and callback_urls",
+			spec: v1.A0ClientSpec{
+				TenantRef: tenantRef(),
+				Policy:    []v1.V1EntityPolicyType{v1.PolicyTypeUpdate},
+				Find:      &v1.ClientFind{ClientId: strPtr("abc"), CallbackUrls: []string{"https://example.com/cb"}},
+				Conf:      &v1.ClientConf{},
+			},
+			want: []string{"spec.find.callback_urls"},
+		},
+		{
+			name: "find without criteria",
+			spec: v1.A0ClientSpec{
+				TenantRef: tenantRef(),
+				Policy:    []v1.V1EntityPolicyType{v1.PolicyTypeUpdate},
+				Find:      &v1.ClientFind{CallbackUrlMatchMode: strPtr("fuzzy")},
+				Conf:      &v1.ClientConf{},
+			},
+			want: []string{"spec.find", "spec.find.callback_url_match_mode", "spec.find.callback_url_match_mode"},
+		},
+		{
+			name: "invalid ignored field",
+			spec: v1.A0ClientSpec{
+				TenantRef:    tenantRef(),
+				IgnoreFields: []string{"logo_uri", "callbacks["},
+				Conf:         &v1.ClientConf{ApplicationType: strPtr("spa")},
+			},
+			want: []string{"spec.ignoreFields[1]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertFields(t, webhook.ValidateA0Client(&v1.A0Client{Spec: tc.spec}), tc.want)
+		})
+	}
+}
+
+func TestValidateA0Connection(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec v1.A0ConnectionSpec
+		want []string
+	}{
+		{
+			name: "valid",
+			spec: v1.A0ConnectionSpec{TenantRef: tenantRef(), Conf: &v1.ConnectionConf{Name: strPtr("db"), Strategy: strPtr("auth0")}},
+		},
+		{
+			name: "missing tenant name",
+			spec: v1.A0ConnectionSpec{TenantRef: &v1.V1TenantReference{}, Conf: &v1.ConnectionConf{}},
+			want: []string{"spec.tenantRef.name"},
+		},
+		{
+			name: "name and strategy differ between init and conf",
+			spec: v1.A0ConnectionSpec{
+				TenantRef: tenantRef(),
+				Init:      &v1.ConnectionConf{Name: strPtr("db-old"), Strategy: strPtr("auth0")},
+				Conf:      &v1.ConnectionConf{Name: strPtr("db"), Strategy: strPtr("google-oauth2")},
+			},
+			want: []string{"spec.init.name", "spec.init.strategy"},
+		},
+		{
+			name: "invalid options",
+			spec: v1.A0ConnectionSpec{
+				TenantRef: tenantRef(),
+				Conf: &v1.ConnectionConf{
+					Strategy: strPtr("auth0"),
+					Options:  &runtime.RawExtension{Raw: []byte(`{"precedence":["email","email"]}`)},
+				},
+			},
+			want: []string{"spec.conf.options.precedence[1]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertFields(t, webhook.ValidateA0Connection(&v1.A0Connection{Spec: tc.spec}), tc.want)
+		})
+	}
+}
+
+func TestValidateA0ClientGrant(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec v1.A0ClientGrantSpec
+		want []string
+	}{
+		{
+			name: "valid",
+			spec: v1.A0ClientGrantSpec{TenantRef: tenantRef(), Conf: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Name: strPtr("web")},
+				Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("https://api.example.com")},
+				Scope:     []string{"read:users"},
+			}},
+		},
+		{
+			name: "missing references",
+			spec: v1.A0ClientGrantSpec{TenantRef: tenantRef(), Conf: &v1.ClientGrantConf{Scope: []string{"read:users"}}},
+			want: []string{"spec.conf.clientRef", "spec.conf.audience"},
+		},
+		{
+			name: "empty references",
+			spec: v1.A0ClientGrantSpec{TenantRef: tenantRef(), Conf: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{},
+				Audience:  &v1.V1ResourceServerReference{},
+				Scope:     []string{"read:users"},
+			}},
+			want: []string{"spec.conf.clientRef", "spec.conf.audience"},
+		},
+		{
+			name: "name together with id",
+			spec: v1.A0ClientGrantSpec{TenantRef: tenantRef(), Conf: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Name: strPtr("web"), Id: strPtr("abc")},
+				Audience:  &v1.V1ResourceServerReference{Name: strPtr("api"), Id: strPtr("rs_1")},
+				Scope:     []string{"read:users"},
+			}},
+			want: []string{"spec.conf.clientRef.id", "spec.conf.audience.id"},
+		},
+		{
+			name: "scope required to create",
+			spec: v1.A0ClientGrantSpec{TenantRef: tenantRef(), Conf: &v1.ClientGrantConf{
+				ClientRef: &v1.V1ClientReference{Name: strPtr("web")},
+				Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("https://api.example.com")},
+			}},
+			want: []string{"spec.conf.scope"},
+		},
+		{
+			name: "client and audience differ between init and conf",
+			spec: v1.A0ClientGrantSpec{
+				TenantRef: tenantRef(),
+				Init: &v1.ClientGrantConf{
+					ClientRef: &v1.V1ClientReference{Name: strPtr("web-old")},
+					Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("https://old.example.com")},
+					Scope:     []string{"read:users"},
+				},
+				Conf: &v1.ClientGrantConf{
+					ClientRef: &v1.V1ClientReference{Name: strPtr("web")},
+					Audience:  &v1.V1ResourceServerReference{Identifier: strPtr("https://api.example.com")},
+				},
+			},
+			want: []string{"spec.init.clientRef.name", "spec.init.audience.identifier"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertFields(t, webhook.ValidateA0ClientGrant(&v1.A0ClientGrant{Spec: tc.spec}), tc.want)
+		})
+	}
+}
+
+func TestValidateA0ResourceServer(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec v1.A0ResourceServerSpec
+		want []string
+	}{
+		{
+			// Auth0 generates the signing secret of an HS256 API when it is not set
+			name: "valid",
+			spec: v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: &v1.ResourceServerConf{
+				Identifier:       strPtr("https://api.example.com"),
+				SigningAlgorithm: strPtr("HS256"),
+			}},
+		},
+		{
+			name: "identifier required to create",
+			spec: v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: &v1.ResourceServerConf{}},
+			want: []string{"spec.conf.identifier"},
+		},
+		{
+			name: "identifier differs between init and conf",
+			spec: v1.A0ResourceServerSpec{
+				TenantRef: tenantRef(),
+				Init:      &v1.ResourceServerConf{Identifier: strPtr("https://old.example.com")},
+				Conf:      &v1.ResourceServerConf{Identifier: strPtr("https://api.example.com")},
+			},
+			want: []string{"spec.init.identifier"},
+		},
+		{
+			name: "missing and duplicate scopes",
+			spec: v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: &v1.ResourceServerConf{
+				Identifier: strPtr("https://api.example.com"),
+				Scopes:     []v1.ResourceServerScope{{Value: strPtr("read")}, {}, {Value: strPtr("read")}},
+			}},
+			want: []string{"spec.conf.scopes[1].value", "spec.conf.scopes[2].value"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertFields(t, webhook.ValidateA0ResourceServer(&v1.A0ResourceServer{Spec: tc.spec}), tc.want)
+		})
+	}
+}
+
+func TestValidateA0Tenant(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec v1.A0TenantSpec
+		want []string
+	}{
+		{
+			name: "valid",
+			spec: v1.A0TenantSpec{
+				Auth: &v1.TenantAuth{Domain: strPtr("example.auth0.com"), SecretRef: &v1.V1SecretReference{Name: "auth0"}},
+				Conf: &v1.TenantConf{},
+			},
+		},
+		{
+			name: "missing auth and conf",
+			want: []string{"spec.auth", "spec.conf"},
+		},
+		{
+			name: "incomplete auth",
+			spec: v1.A0TenantSpec{Auth: &v1.TenantAuth{}, Conf: &v1.TenantConf{}},
+			want: []string{"spec.auth.domain", "spec.auth.secretRef"},
+		},
+		{
+			name: "secret without name and duplicate policy",
+			spec: v1.A0TenantSpec{
+				Policy: []v1.V1EntityPolicyType{v1.PolicyTypeCreate, v1.PolicyTypeUpdate, v1.PolicyTypeCreate},
+				Auth:   &v1.TenantAuth{Domain: strPtr("example.auth0.com"), SecretRef: &v1.V1SecretReference{}},
+				Conf:   &v1.TenantConf{},
+			},
+			want: []string{"spec.policy[2]", "spec.auth.secretRef.name"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertFields(t, webhook.ValidateA0Tenant(&v1.A0Tenant{Spec: tc.spec}), tc.want)
+		})
+	}
+}
+
+func TestValidatorValidateCreate(t *testing.T) {
+	v := webhook.NewA0ResourceServerValidator()
+	obj := &v1.A0ResourceServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: namespace},
+		Spec:       v1.A0ResourceServerSpec{TenantRef: tenantRef(), Conf: &v1.ResourceServerConf{Scopes: []v1.ResourceServerScope{{Value: strPtr("read")}, {Value: strPtr("read")}}}},
+	}
+
+	_, err := v.ValidateCreate(context.Background(), obj)
+	if !apierrors.IsInvalid(err) {
+		t.Fatalf("expected an Invalid error, got %v", err)
+	}
+	status := err.(apierrors.APIStatus).Status()
+	if status.Details.Kind != "A0ResourceServer" || status.Details.Name != "api" || len(status.Details.Causes) != 2 {
+		t.Errorf("unexpected details %+v", status.Details)
+	}
+
+	obj.Spec.Conf.Identifier, obj.Spec.Conf.Scopes = strPtr("https://api.example.com"), nil
+	if _, err := v.ValidateCreate(context.Background(), obj); err != nil {
+		t.Errorf("expected a valid resource server, got %v", err)
+	}
+
+	if _, err := v.ValidateCreate(context.Background(), &v1.A0Client{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected a BadRequest error for another kind, got %v", err)
+	}
+}
+
+func assertFields(t *testing.T, errs field.ErrorList, want []string) {
+	t.Helper()
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Field)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got errors for %v, want %v: %v", got, want, errs.ToAggregate())
+	}
+}
+
+const namespace = "default"
+
+func tenantRef() *v1.V1TenantReference {
+	return &v1.V1TenantReference{Name: "tenant"}
+}
+
+func strPtr(s string) *string { return &s }
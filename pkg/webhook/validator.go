@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/seatgeek/auth0-operator/api/v1"
+)
+
+// +kubebuilder:webhook:path=/validate-kubernetes-auth0-com-v1-a0client,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0clients,verbs=create;update,versions=v1,name=va0client.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubernetes-auth0-com-v1-a0connection,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0connections,verbs=create;update,versions=v1,name=va0connection.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubernetes-auth0-com-v1-a0clientgrant,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0clientgrants,verbs=create;update,versions=v1,name=va0clientgrant.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubernetes-auth0-com-v1-a0resourceserver,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0resourceservers,verbs=create;update,versions=v1,name=va0resourceserver.kubernetes.auth0.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubernetes-auth0-com-v1-a0tenant,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubernetes.auth0.com,resources=a0tenants,verbs=create;update,versions=v1,name=va0tenant.kubernetes.auth0.com,admissionReviewVersions=v1
+
+// Validator is a validating admission webhook for a single A0* kind
+type Validator[T client.Object] struct {
+	validate func(T) field.ErrorList
+}
+
+var _ webhook.CustomValidator = &Validator[*v1.A0Client]{}
+
+// NewA0ClientValidator returns a validator for A0Client resources
+func NewA0ClientValidator() *Validator[*v1.A0Client] {
+	return &Validator[*v1.A0Client]{validate: ValidateA0Client}
+}
+
+// NewA0ConnectionValidator returns a validator for A0Connection resources
+func NewA0ConnectionValidator() *Validator[*v1.A0Connection] {
+	return &Validator[*v1.A0Connection]{validate: ValidateA0Connection}
+}
+
+// NewA0ClientGrantValidator returns a validator for A0ClientGrant resources
+func NewA0ClientGrantValidator() *Validator[*v1.A0ClientGrant] {
+	return &Validator[*v1.A0ClientGrant]{validate: ValidateA0ClientGrant}
+}
+
+// NewA0ResourceServerValidator returns a validator for A0ResourceServer resources
+func NewA0ResourceServerValidator() *Validator[*v1.A0ResourceServer] {
+	return &Validator[*v1.A0ResourceServer]{validate: ValidateA0ResourceServer}
+}
+
+// NewA0TenantValidator returns a validator for A0Tenant resources
+func NewA0TenantValidator() *Validator[*v1.A0Tenant] {
+	return &Validator[*v1.A0Tenant]{validate: ValidateA0Tenant}
+}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *Validator[T]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validateObject(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *Validator[T]) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validateObject(newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *Validator[T]) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *Validator[T]) validateObject(obj runtime.Object) error {
+	o, ok := obj.(T)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("unexpected object type %T", obj))
+	}
+
+	allErrs := v.validate(o)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	gk := schema.GroupKind{Group: v1.GroupVersion.Group, Kind: kindOf(o)}
+	return apierrors.NewInvalid(gk, o.GetName(), allErrs)
+}
+
+// kindOf returns the kind of obj, which is not always set on decoded admission objects.
+func kindOf(obj client.Object) string {
+	switch obj.(type) {
+	case *v1.A0Client:
+		return "A0Client"
+	case *v1.A0Connection:
+		return "A0Connection"
+	case *v1.A0ClientGrant:
+		return "A0ClientGrant"
+	case *v1.A0ResourceServer:
+		return "A0ResourceServer"
+	case *v1.A0Tenant:
+		return "A0Tenant"
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
+// SetupValidatingWebhooksWithManager registers the validating webhooks of all A0* kinds with mgr
+func SetupValidatingWebhooksWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0Client{}).WithValidator(NewA0ClientValidator()).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0Connection{}).WithValidator(NewA0ConnectionValidator()).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0ClientGrant{}).WithValidator(NewA0ClientGrantValidator()).Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1.A0ResourceServer{}).WithValidator(NewA0ResourceServerValidator()).Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&v1.A0Tenant{}).WithValidator(NewA0TenantValidator()).Complete()
+}